@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// jsonDiagnostic is a diagnostic, as reported by the -json flag.
+type jsonDiagnostic struct {
+	File        string   `json:"file,omitempty"`
+	Line        int      `json:"line,omitempty"`
+	Column      int      `json:"column,omitempty"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// fatal reports the error, described by msg, and exits. If jsonOut is
+// set, the error's diagnostics are written to stderr as a JSON array (an
+// error that isn't a diagnostic is reported as one without a position,
+// prefixed with msg). Otherwise, the error is logged.
+func fatal(jsonOut bool, msg string, err error) {
+	if !jsonOut {
+		if err == nil {
+			log.Fatal(msg)
+		}
+		log.Fatalf("%s: %s", msg, err)
+	}
+
+	var (
+		diag     *iface.Diagnostic
+		typeErrs *iface.TypeErrors
+	)
+	switch {
+	case err == nil:
+		err = errors.New(msg)
+	case !errors.As(err, &diag) && !errors.As(err, &typeErrs):
+		err = fmt.Errorf("%s: %w", msg, err)
+	}
+
+	var diags []jsonDiagnostic
+	for _, diag := range iface.Diagnostics(err) {
+		diags = append(diags, jsonDiagnostic{
+			File:        diag.Position.Filename,
+			Line:        diag.Position.Line,
+			Column:      diag.Position.Column,
+			Message:     diag.Message,
+			Suggestions: diag.Suggestions,
+		})
+	}
+	if err := json.NewEncoder(os.Stderr).Encode(diags); err != nil {
+		log.Fatalf("Error encoding diagnostics: %s", err)
+	}
+	os.Exit(1)
+}
@@ -0,0 +1,275 @@
+// Package diff produces human-readable structural diffs of Go values,
+// for use in the failure messages of generated mocks.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxLines is the maximum number of differences reported by Diff.
+const maxLines = 20
+
+// Diff describes the differences between want and got, one per line. Each
+// line begins with the path to the differing value within want and got
+// (e.g. ".Items[2].Name"), and is followed by the wanted and actual values.
+// Diff returns an empty string if want and got are deeply equal.
+func Diff(want, got any) string {
+	d := &differ{visited: map[visit]bool{}}
+	d.diff("", reflect.ValueOf(want), reflect.ValueOf(got))
+	if len(d.lines) > maxLines {
+		d.lines = append(d.lines[:maxLines], fmt.Sprintf("...and %d more differences", len(d.lines)-maxLines))
+	}
+	return strings.Join(d.lines, "\n")
+}
+
+// visit is a pair of pointers that have already been compared,
+// which prevents infinite recursion on cyclic data structures.
+type visit struct {
+	want, got uintptr
+	typ       reflect.Type
+}
+
+type differ struct {
+	lines   []string
+	visited map[visit]bool
+}
+
+func (d *differ) report(path string, want, got string) {
+	if path == "" {
+		path = "value"
+	}
+	d.lines = append(d.lines, fmt.Sprintf("%s: want %s, got %s", path, want, got))
+}
+
+func (d *differ) diff(path string, want, got reflect.Value) {
+	if !want.IsValid() || !got.IsValid() {
+		if want.IsValid() != got.IsValid() {
+			d.report(path, format(want), format(got))
+		}
+		return
+	}
+	if want.Type() != got.Type() {
+		d.report(path, fmt.Sprintf("%s (%s)", format(want), want.Type()), fmt.Sprintf("%s (%s)", format(got), got.Type()))
+		return
+	}
+
+	switch want.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() != got.IsNil() {
+				d.report(path, format(want), format(got))
+			}
+			return
+		}
+		if want.Kind() != reflect.Slice || want.Len() > 0 {
+			v := visit{want.Pointer(), got.Pointer(), want.Type()}
+			if v.want == v.got || d.visited[v] {
+				return
+			}
+			d.visited[v] = true
+		}
+	}
+
+	switch want.Kind() {
+	case reflect.Pointer:
+		d.diff(path, want.Elem(), got.Elem())
+
+	case reflect.Interface:
+		d.diff(path, want.Elem(), got.Elem())
+
+	case reflect.Struct:
+		for i := range want.NumField() {
+			d.diff(fmt.Sprintf("%s.%s", path, want.Type().Field(i).Name), want.Field(i), got.Field(i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range max(want.Len(), got.Len()) {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= got.Len():
+				d.report(elemPath, format(want.Index(i)), "nothing")
+			case i >= want.Len():
+				d.report(elemPath, "nothing", format(got.Index(i)))
+			default:
+				d.diff(elemPath, want.Index(i), got.Index(i))
+			}
+		}
+
+	case reflect.Map:
+		for _, key := range sortedKeys(want, got) {
+			keyPath := fmt.Sprintf("%s[%s]", path, formatKey(key))
+			wantElem, gotElem := want.MapIndex(key), got.MapIndex(key)
+			switch {
+			case !gotElem.IsValid():
+				d.report(keyPath, format(wantElem), "nothing")
+			case !wantElem.IsValid():
+				d.report(keyPath, "nothing", format(gotElem))
+			default:
+				d.diff(keyPath, wantElem, gotElem)
+			}
+		}
+
+	case reflect.Func:
+		if !want.IsNil() || !got.IsNil() {
+			d.report(path, format(want), format(got))
+		}
+
+	default:
+		if format(want) != format(got) {
+			d.report(path, format(want), format(got))
+		}
+	}
+}
+
+// sortedKeys returns the union of the keys of two maps,
+// sorted by their formatted representation.
+func sortedKeys(maps ...reflect.Value) []reflect.Value {
+	var (
+		keys []reflect.Value
+		seen = map[string]bool{}
+	)
+	for _, m := range maps {
+		for _, key := range m.MapKeys() {
+			str := formatKey(key)
+			if !seen[str] {
+				seen[str] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return formatKey(keys[i]) < formatKey(keys[j])
+	})
+	return keys
+}
+
+// formatKey formats a map key, along with its type if the map's keys are
+// interfaces, since keys of different types can be formatted the same way
+// (e.g. 1 and int64(1)).
+func formatKey(key reflect.Value) string {
+	if key.Kind() == reflect.Interface && !key.IsNil() {
+		return fmt.Sprintf("%s (%s)", format(key), key.Elem().Type())
+	}
+	return format(key)
+}
+
+// Format formats a value on a single line. Unlike the %v verb, it includes
+// struct field names, quotes strings, sorts map keys, and formats the values
+// pointed to by pointers (rather than their addresses).
+func Format(v any) string {
+	return format(reflect.ValueOf(v))
+}
+
+func format(v reflect.Value) string {
+	var s strings.Builder
+	formatTo(&s, v, map[uintptr]bool{})
+	return s.String()
+}
+
+func formatTo(s *strings.Builder, v reflect.Value, visited map[uintptr]bool) {
+	if !v.IsValid() {
+		s.WriteString("nil")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			fmt.Fprintf(s, "%s(nil)", v.Type())
+			return
+		}
+		if v.Kind() == reflect.Pointer || v.Len() > 0 {
+			if visited[v.Pointer()] {
+				s.WriteString("<cycle>")
+				return
+			}
+			visited[v.Pointer()] = true
+			defer delete(visited, v.Pointer())
+		}
+	}
+
+	// Errors and Stringers are best described by their own messages
+	if v.Kind() != reflect.Interface && v.CanInterface() {
+		switch iface := v.Interface().(type) {
+		case error:
+			fmt.Fprintf(s, "%s(%q)", v.Type(), iface.Error())
+			return
+		case fmt.Stringer:
+			fmt.Fprintf(s, "%s(%q)", v.Type(), iface.String())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		fmt.Fprintf(s, "%t", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(s, "%d", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		fmt.Fprintf(s, "%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(s, "%g", v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprintf(s, "%g", v.Complex())
+	case reflect.String:
+		fmt.Fprintf(s, "%q", v.String())
+
+	case reflect.Pointer:
+		s.WriteByte('&')
+		formatTo(s, v.Elem(), visited)
+
+	case reflect.Interface:
+		formatTo(s, v.Elem(), visited)
+
+	case reflect.Struct:
+		fmt.Fprintf(s, "%s{", v.Type())
+		for i := range v.NumField() {
+			if i > 0 {
+				s.WriteString(", ")
+			}
+			fmt.Fprintf(s, "%s: ", v.Type().Field(i).Name)
+			formatTo(s, v.Field(i), visited)
+		}
+		s.WriteByte('}')
+
+	case reflect.Slice, reflect.Array:
+		fmt.Fprintf(s, "%s{", v.Type())
+		for i := range v.Len() {
+			if i > 0 {
+				s.WriteString(", ")
+			}
+			formatTo(s, v.Index(i), visited)
+		}
+		s.WriteByte('}')
+
+	case reflect.Map:
+		fmt.Fprintf(s, "%s{", v.Type())
+		for i, key := range sortedKeys(v) {
+			if i > 0 {
+				s.WriteString(", ")
+			}
+			formatTo(s, key, visited)
+			s.WriteString(": ")
+			formatTo(s, v.MapIndex(key), visited)
+		}
+		s.WriteByte('}')
+
+	case reflect.Func:
+		if v.IsNil() {
+			fmt.Fprintf(s, "%s(nil)", v.Type())
+		} else {
+			fmt.Fprintf(s, "%s{...}", v.Type())
+		}
+
+	default:
+		// Channels and unsafe pointers
+		if v.IsNil() {
+			fmt.Fprintf(s, "%s(nil)", v.Type())
+		} else {
+			fmt.Fprintf(s, "%s(%#x)", v.Type(), v.Pointer())
+		}
+	}
+}
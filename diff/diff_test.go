@@ -0,0 +1,132 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type item struct {
+	Name string
+	Tags []string
+	Next *item
+}
+
+type key struct {
+	ID  string
+	Rev int
+}
+
+type node struct {
+	Value    int
+	Children []*node
+}
+
+func TestDiff(t *testing.T) {
+	// Cyclic values, which differ only in the value of the second node
+	cycle := func(second int) *item {
+		a, b := &item{Name: "a"}, &item{Name: fmt.Sprint(second)}
+		a.Next, b.Next = b, a
+		return a
+	}
+	tree := func(leaf int) *node {
+		n := &node{Value: 1}
+		n.Children = []*node{n, {Value: leaf}}
+		return n
+	}
+
+	tests := []struct {
+		name      string
+		want, got any
+		diff      string
+	}{
+		{"equal", item{Name: "a"}, item{Name: "a"}, ""},
+		{"value", 1, 2, "value: want 1, got 2"},
+		{"types", 1, int64(1), "value: want 1 (int), got 1 (int64)"},
+		{"nil", nil, 1, "value: want nil, got 1"},
+		{"nil pointer", (*item)(nil), &item{}, `value: want *diff.item(nil), got &diff.item{Name: "", Tags: []string(nil), Next: *diff.item(nil)}`},
+		{"field", item{Name: "a"}, item{Name: "b"}, `.Name: want "a", got "b"`},
+		{"nested field", &item{Next: &item{Name: "a"}}, &item{Next: &item{Name: "b"}}, `.Next.Name: want "a", got "b"`},
+		{"element", []int{1, 2}, []int{1, 3}, "[1]: want 2, got 3"},
+		{"missing element", []int{1, 2}, []int{1}, "[1]: want 2, got nothing"},
+		{"extra element", [1][]int{{1}}, [1][]int{{1, 2}}, "[0][1]: want nothing, got 2"},
+		{"map value", map[string]int{"a": 1}, map[string]int{"a": 2}, `["a"]: want 1, got 2`},
+		{"missing key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, `["b"]: want 2, got nothing`},
+		{"extra key", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, `["b"]: want nothing, got 2`},
+		{"struct keys", map[key]int{{"a", 1}: 1, {"a", 2}: 1}, map[key]int{{"a", 1}: 2}, "[diff.key{ID: \"a\", Rev: 1}]: want 1, got 2\n[diff.key{ID: \"a\", Rev: 2}]: want 1, got nothing"},
+		{"interface keys", map[any]int{1: 1}, map[any]int{int64(1): 1}, "[1 (int)]: want 1, got nothing\n[1 (int64)]: want nothing, got 1"},
+		{"sorted keys", map[int]int{2: 2, 1: 1}, map[int]int{}, "[1]: want 1, got nothing\n[2]: want 2, got nothing"},
+		{"interface", []any{1, "a"}, []any{1, 2}, `[1]: want "a" (string), got 2 (int)`},
+		{"func", func() {}, (func())(nil), "value: want func(){...}, got func()(nil)"},
+		{"nil funcs", (func())(nil), (func())(nil), ""},
+		{"equal cycles", cycle(1), cycle(1), ""},
+		{"cycles", cycle(1), cycle(2), `.Next.Name: want "1", got "2"`},
+		{"self-referential slices", tree(1), tree(2), ".Children[1].Value: want 1, got 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.want, tt.got); got != tt.diff {
+				t.Errorf("Diff() =\n%s\nwant:\n%s", got, tt.diff)
+			}
+		})
+	}
+}
+
+func TestDiffMaxLines(t *testing.T) {
+	want, got := make([]int, maxLines+5), make([]int, maxLines+5)
+	for i := range got {
+		got[i] = 1
+	}
+	lines := strings.Split(Diff(want, got), "\n")
+	if len(lines) != maxLines+1 {
+		t.Fatalf("Diff() returned %d lines, want %d", len(lines), maxLines+1)
+	}
+	if last, want := lines[maxLines], "...and 5 more differences"; last != want {
+		t.Errorf("last line = %q, want %q", last, want)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	self := &item{Name: "a"}
+	self.Next = self
+	zero := new(int)
+	var nilErr error
+
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"nil", nil, "nil"},
+		{"nil interface", nilErr, "nil"},
+		{"bool", true, "true"},
+		{"int", -1, "-1"},
+		{"uint", uint8(1), "1"},
+		{"float", 1.5, "1.5"},
+		{"complex", complex(1, 2), "(1+2i)"},
+		{"string", "a\n", `"a\n"`},
+		{"struct", item{Name: "a", Tags: []string{"b"}}, `diff.item{Name: "a", Tags: []string{"b"}, Next: *diff.item(nil)}`},
+		{"pointer", &[]int{1}, "&[]int{1}"},
+		{"nil slice", []int(nil), "[]int(nil)"},
+		{"empty slice", []int{}, "[]int{}"},
+		{"array", [2]bool{}, "[2]bool{false, false}"},
+		{"map", map[string]int{"b": 2, "a": 1}, `map[string]int{"a": 1, "b": 2}`},
+		{"nil map", map[string]int(nil), "map[string]int(nil)"},
+		{"interfaces", []any{1, nil}, "[]interface {}{1, nil}"},
+		{"error", errors.New("failed"), `*errors.errorString("failed")`},
+		{"stringer", time.Second, `time.Duration("1s")`},
+		{"func", func() {}, "func(){...}"},
+		{"nil chan", (chan int)(nil), "chan int(nil)"},
+		{"cycle", self, `&diff.item{Name: "a", Tags: []string(nil), Next: <cycle>}`},
+		{"repeated pointer", []*int{zero, zero, nil}, "[]*int{&0, &0, *int(nil)}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.v); got != tt.want {
+				t.Errorf("Format() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// directive is a go:generate directive that runs mock.
+type directive struct {
+	// Position of the directive
+	pos string
+
+	// Absolute path of the directory of the file it's in
+	dir string
+
+	// Its options, with the -d and -o paths made absolute
+	opts      options
+	ifaceName string
+}
+
+// findDirectives returns the go:generate directives
+// running mock in the Go source files in the directories.
+func findDirectives(dirs []string) ([]directive, error) {
+	var directives []directive
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			found, err := fileDirectives(file)
+			if err != nil {
+				return nil, err
+			}
+			directives = append(directives, found...)
+		}
+	}
+	return directives, nil
+}
+
+// fileDirectives returns the go:generate directives running mock in a file.
+// Directives that mock can't parse are logged and skipped.
+func fileDirectives(file string) ([]directive, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(data, []byte("//go:generate")) {
+		return nil, nil
+	}
+	pkgName, err := packageName(filepath.Dir(file))
+	if err != nil {
+		return nil, err
+	}
+
+	var directives []directive
+	for i, line := range strings.Split(string(data), "\n") {
+		args, ok := mockArgs(line)
+		if !ok {
+			continue
+		}
+		d := directive{
+			pos: fmt.Sprintf("%s:%d", relative(file), i+1),
+			dir: filepath.Dir(file),
+		}
+		env := generateEnv(file, i+1, pkgName)
+		for j, arg := range args {
+			args[j] = os.Expand(arg, env)
+		}
+
+		flags := flag.NewFlagSet("mock", flag.ContinueOnError)
+		flags.SetOutput(io.Discard)
+		d.opts.register(flags)
+		if err := flags.Parse(args); err != nil || flags.NArg() != 1 {
+			if err == nil {
+				err = errors.New("expected one interface name")
+			}
+			log.Printf("%s: skipping directive: %s", d.pos, err)
+			continue
+		}
+		d.ifaceName = flags.Arg(0)
+		d.opts.dir = filepath.Join(d.dir, d.opts.dir)
+		if d.opts.outFile != "" && !filepath.IsAbs(d.opts.outFile) {
+			d.opts.outFile = filepath.Join(d.dir, d.opts.outFile)
+		}
+		directives = append(directives, d)
+	}
+	return directives, nil
+}
+
+// mockArgs returns the arguments to mock in a go:generate directive, which
+// either runs mock directly or with "go run". Subcommands are ignored.
+func mockArgs(line string) ([]string, bool) {
+	directive, ok := strings.CutPrefix(line, "//go:generate ")
+	if !ok {
+		return nil, false
+	}
+	words, err := splitDirective(directive)
+	if err != nil || len(words) == 0 {
+		return nil, false
+	}
+	var args []string
+	switch {
+	case len(words) > 2 && words[0] == "go" && words[1] == "run":
+		pkg, _, _ := strings.Cut(words[2], "@")
+		if path.Base(pkg) != "mock" {
+			return nil, false
+		}
+		args = words[3:]
+	case words[0] == "mock":
+		args = words[1:]
+	default:
+		return nil, false
+	}
+	if len(args) > 0 && args[0] == "migrate" {
+		return nil, false
+	}
+	return args, true
+}
+
+// relative returns the path relative to the working directory, if it's
+// inside it, for logging.
+func relative(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
+
+// directiveResult is the result of running a directive.
+type directiveResult struct {
+	directive
+
+	// The file the implementation was (or would be) written to (if known),
+	// and whether it was written, rather than already being up to date
+	outFile string
+	written bool
+	err     error
+}
+
+// runDirectives runs the directives, with up to jobs packages being loaded
+// or implementations being generated at a time. Each package is only loaded
+// once, however many of the directives refer to it. Directives whose output
+// is up to date (according to its fingerprint) are skipped, unless forced.
+// The results are in the same order as the directives.
+func runDirectives(directives []directive, jobs int) []directiveResult {
+	results := make([]directiveResult, len(directives))
+
+	// Group the directives by the package they load
+	var groups [][]int
+	groupIndexes := map[string]int{}
+	for i, d := range directives {
+		results[i].directive = d
+		if d.opts.outFile == "" {
+			results[i].err = errors.New("no output file provided with -o")
+			continue
+		}
+		key := fmt.Sprint(d.opts.dir, d.opts.config())
+		index, ok := groupIndexes[key]
+		if !ok {
+			index = len(groups)
+			groupIndexes[key] = index
+			groups = append(groups, nil)
+		}
+		groups[index] = append(groups[index], i)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(1, jobs))
+	for _, group := range groups {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			opts := directives[group[0]].opts
+			loader, err := iface.NewLoader(opts.config(), opts.dir)
+			<-sem
+
+			for _, i := range group {
+				if err != nil {
+					results[i].err = fmt.Errorf("error loading package: %w", err)
+					continue
+				}
+				iface, err := loader.Interface(directives[i].ifaceName)
+				if err != nil {
+					results[i].err = fmt.Errorf("error getting interface information: %w", err)
+					continue
+				}
+
+				wg.Add(1)
+				sem <- struct{}{}
+				go func() {
+					defer wg.Done()
+					defer func() { <-sem }()
+					runDirective(&results[i], iface)
+				}()
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// runDirective generates the implementation of the
+// interface for the result's directive, and writes it.
+func runDirective(result *directiveResult, iface iface.Interface) {
+	opts := result.opts
+	result.outFile = outputFile(iface, opts.outFile)
+	if !opts.force && upToDate(result.outFile, iface, opts.styleName, opts.naming) {
+		return
+	}
+	output, err := generate(iface, opts.styleName, opts.naming, result.outFile)
+	if err != nil {
+		result.err = fmt.Errorf("error generating %s: %w", opts.styleName, err)
+		return
+	}
+	result.written, err = writeIfChanged(result.outFile, output)
+	if err != nil {
+		result.err = fmt.Errorf("error writing output: %w", err)
+	}
+}
+
+// generateAll implements the -generate flag, which runs the go:generate
+// directives running mock in the directories matching the given patterns
+// (see expandPatterns) in parallel, with up to jobs at a time. The errors
+// of all of the directives that fail are reported, after which it exits
+// with a non-zero status.
+func generateAll(patterns []string, jobs int) {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	dirs, err := expandPatterns(patterns)
+	if err != nil {
+		log.Fatalf("Error finding packages: %s", err)
+	}
+	for i, dir := range dirs {
+		if dirs[i], err = filepath.Abs(dir); err != nil {
+			log.Fatalf("Error finding packages: %s", err)
+		}
+	}
+	directives, err := findDirectives(dirs)
+	if err != nil {
+		log.Fatalf("Error finding go:generate directives: %s", err)
+	}
+
+	var written, failed int
+	for _, result := range runDirectives(directives, jobs) {
+		switch {
+		case result.err != nil:
+			log.Printf("%s: %s", result.pos, result.err)
+			failed++
+		case result.written:
+			log.Printf("Generated %s", relative(result.outFile))
+			written++
+		}
+	}
+	log.Printf("%d of %d directives generated new output, %d failed", written, len(directives), failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
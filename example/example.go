@@ -9,9 +9,6 @@ import (
 	"github.com/nathanjcochran/mock/example/internal"
 )
 
-// TODO: chan types
-// TODO: map types
-
 // Example is an example interface with a large number of
 // methods of different signatures.
 //
@@ -55,6 +52,11 @@ type Example interface {
 	EmbeddedInterfaceParam(intf interface {
 		fmt.Stringer
 	})
+	ChanParam(ch chan int)
+	DirectionalChanParams(recv <-chan int, send chan<- int)
+	ChanVariadicParam(chs ...chan int)
+	MapParam(m map[string]int)
+	MapVariadicParam(ms ...map[string]int)
 
 	UnnamedReturn() error
 	MultipleUnnamedReturn() (int, error)
@@ -76,4 +78,6 @@ type Example interface {
 	EmbeddedInterfaceReturn() (intf interface {
 		fmt.Stringer
 	})
+	ChanReturn() (ch <-chan int)
+	MapReturn() (m map[string]int)
 }
@@ -1,18 +1,29 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: e5e015bdb6850801
+
 package example
 
 import (
 	"fmt"
 	"html/template"
+	"math/rand/v2"
 	. "os"
+	"sync"
 	"sync/atomic"
 	"testing"
 	renamed "text/template"
 
 	"github.com/nathanjcochran/mock/example/internal"
+	"github.com/nathanjcochran/mock/match"
+	"github.com/nathanjcochran/mock/sequence"
+	"github.com/nathanjcochran/mock/usage"
 )
 
 // ExampleMock is a mock implementation of the Example
 // interface.
+//
+// Example is an example interface with a large number of
+// methods of different signatures.
 type ExampleMock struct {
 	T                                        *testing.T
 	NoParamsOrReturnStub                     func()
@@ -71,6 +82,16 @@ type ExampleMock struct {
 	InterfaceVariadicFuncVariadicParamCalled int32
 	EmbeddedInterfaceParamStub               func(intf interface{ fmt.Stringer })
 	EmbeddedInterfaceParamCalled             int32
+	ChanParamStub                            func(ch chan int)
+	ChanParamCalled                          int32
+	DirectionalChanParamsStub                func(recv <-chan int, send chan<- int)
+	DirectionalChanParamsCalled              int32
+	ChanVariadicParamStub                    func(chs ...chan int)
+	ChanVariadicParamCalled                  int32
+	MapParamStub                             func(m map[string]int)
+	MapParamCalled                           int32
+	MapVariadicParamStub                     func(ms ...map[string]int)
+	MapVariadicParamCalled                   int32
 	UnnamedReturnStub                        func() error
 	UnnamedReturnCalled                      int32
 	MultipleUnnamedReturnStub                func() (int, error)
@@ -99,6 +120,110 @@ type ExampleMock struct {
 	InterfaceVariadicFuncReturnCalled        int32
 	EmbeddedInterfaceReturnStub              func() (intf interface{ fmt.Stringer })
 	EmbeddedInterfaceReturnCalled            int32
+	ChanReturnStub                           func() (ch <-chan int)
+	ChanReturnCalled                         int32
+	MapReturnStub                            func() (m map[string]int)
+	MapReturnCalled                          int32
+
+	mu                                             sync.Mutex
+	callsNoParamsOrReturn                          []ExampleMockNoParamsOrReturnArgs
+	expectationsNoParamsOrReturn                   []*ExampleMockNoParamsOrReturnExpectation
+	callsUnnamedParam                              []ExampleMockUnnamedParamArgs
+	expectationsUnnamedParam                       []*ExampleMockUnnamedParamExpectation
+	callsUnnamedVariadicParam                      []ExampleMockUnnamedVariadicParamArgs
+	expectationsUnnamedVariadicParam               []*ExampleMockUnnamedVariadicParamExpectation
+	callsBlankParam                                []ExampleMockBlankParamArgs
+	expectationsBlankParam                         []*ExampleMockBlankParamExpectation
+	callsBlankVariadicParam                        []ExampleMockBlankVariadicParamArgs
+	expectationsBlankVariadicParam                 []*ExampleMockBlankVariadicParamExpectation
+	callsNamedParam                                []ExampleMockNamedParamArgs
+	expectationsNamedParam                         []*ExampleMockNamedParamExpectation
+	callsNamedVariadicParam                        []ExampleMockNamedVariadicParamArgs
+	expectationsNamedVariadicParam                 []*ExampleMockNamedVariadicParamExpectation
+	callsSameTypeNamedParams                       []ExampleMockSameTypeNamedParamsArgs
+	expectationsSameTypeNamedParams                []*ExampleMockSameTypeNamedParamsExpectation
+	callsInternalTypeParam                         []ExampleMockInternalTypeParamArgs
+	expectationsInternalTypeParam                  []*ExampleMockInternalTypeParamExpectation
+	callsImportedParam                             []ExampleMockImportedParamArgs
+	expectationsImportedParam                      []*ExampleMockImportedParamExpectation
+	callsImportedVariadicParam                     []ExampleMockImportedVariadicParamArgs
+	expectationsImportedVariadicParam              []*ExampleMockImportedVariadicParamExpectation
+	callsRenamedImportParam                        []ExampleMockRenamedImportParamArgs
+	expectationsRenamedImportParam                 []*ExampleMockRenamedImportParamExpectation
+	callsRenamedImportVariadicParam                []ExampleMockRenamedImportVariadicParamArgs
+	expectationsRenamedImportVariadicParam         []*ExampleMockRenamedImportVariadicParamExpectation
+	callsDotImportParam                            []ExampleMockDotImportParamArgs
+	expectationsDotImportParam                     []*ExampleMockDotImportParamExpectation
+	callsDotImportVariadicParam                    []ExampleMockDotImportVariadicParamArgs
+	expectationsDotImportVariadicParam             []*ExampleMockDotImportVariadicParamExpectation
+	callsSelfReferentialParam                      []ExampleMockSelfReferentialParamArgs
+	expectationsSelfReferentialParam               []*ExampleMockSelfReferentialParamExpectation
+	callsSelfReferentialVariadicParam              []ExampleMockSelfReferentialVariadicParamArgs
+	expectationsSelfReferentialVariadicParam       []*ExampleMockSelfReferentialVariadicParamExpectation
+	callsStructParam                               []ExampleMockStructParamArgs
+	expectationsStructParam                        []*ExampleMockStructParamExpectation
+	callsStructVariadicParam                       []ExampleMockStructVariadicParamArgs
+	expectationsStructVariadicParam                []*ExampleMockStructVariadicParamExpectation
+	callsEmbeddedStructParam                       []ExampleMockEmbeddedStructParamArgs
+	expectationsEmbeddedStructParam                []*ExampleMockEmbeddedStructParamExpectation
+	callsEmbeddedStructVariadicParam               []ExampleMockEmbeddedStructVariadicParamArgs
+	expectationsEmbeddedStructVariadicParam        []*ExampleMockEmbeddedStructVariadicParamExpectation
+	callsEmptyInterfaceParam                       []ExampleMockEmptyInterfaceParamArgs
+	expectationsEmptyInterfaceParam                []*ExampleMockEmptyInterfaceParamExpectation
+	callsEmptyInterfaceVariadicParam               []ExampleMockEmptyInterfaceVariadicParamArgs
+	expectationsEmptyInterfaceVariadicParam        []*ExampleMockEmptyInterfaceVariadicParamExpectation
+	callsInterfaceParam                            []ExampleMockInterfaceParamArgs
+	expectationsInterfaceParam                     []*ExampleMockInterfaceParamExpectation
+	callsInterfaceVariadicParam                    []ExampleMockInterfaceVariadicParamArgs
+	expectationsInterfaceVariadicParam             []*ExampleMockInterfaceVariadicParamExpectation
+	callsInterfaceVariadicFuncParam                []ExampleMockInterfaceVariadicFuncParamArgs
+	expectationsInterfaceVariadicFuncParam         []*ExampleMockInterfaceVariadicFuncParamExpectation
+	callsInterfaceVariadicFuncVariadicParam        []ExampleMockInterfaceVariadicFuncVariadicParamArgs
+	expectationsInterfaceVariadicFuncVariadicParam []*ExampleMockInterfaceVariadicFuncVariadicParamExpectation
+	callsEmbeddedInterfaceParam                    []ExampleMockEmbeddedInterfaceParamArgs
+	expectationsEmbeddedInterfaceParam             []*ExampleMockEmbeddedInterfaceParamExpectation
+	callsChanParam                                 []ExampleMockChanParamArgs
+	expectationsChanParam                          []*ExampleMockChanParamExpectation
+	callsDirectionalChanParams                     []ExampleMockDirectionalChanParamsArgs
+	expectationsDirectionalChanParams              []*ExampleMockDirectionalChanParamsExpectation
+	callsChanVariadicParam                         []ExampleMockChanVariadicParamArgs
+	expectationsChanVariadicParam                  []*ExampleMockChanVariadicParamExpectation
+	callsMapParam                                  []ExampleMockMapParamArgs
+	expectationsMapParam                           []*ExampleMockMapParamExpectation
+	callsMapVariadicParam                          []ExampleMockMapVariadicParamArgs
+	expectationsMapVariadicParam                   []*ExampleMockMapVariadicParamExpectation
+	callsUnnamedReturn                             []ExampleMockUnnamedReturnArgs
+	expectationsUnnamedReturn                      []*ExampleMockUnnamedReturnExpectation
+	callsMultipleUnnamedReturn                     []ExampleMockMultipleUnnamedReturnArgs
+	expectationsMultipleUnnamedReturn              []*ExampleMockMultipleUnnamedReturnExpectation
+	callsBlankReturn                               []ExampleMockBlankReturnArgs
+	expectationsBlankReturn                        []*ExampleMockBlankReturnExpectation
+	callsNamedReturn                               []ExampleMockNamedReturnArgs
+	expectationsNamedReturn                        []*ExampleMockNamedReturnExpectation
+	callsSameTypeNamedReturn                       []ExampleMockSameTypeNamedReturnArgs
+	expectationsSameTypeNamedReturn                []*ExampleMockSameTypeNamedReturnExpectation
+	callsRenamedImportReturn                       []ExampleMockRenamedImportReturnArgs
+	expectationsRenamedImportReturn                []*ExampleMockRenamedImportReturnExpectation
+	callsDotImportReturn                           []ExampleMockDotImportReturnArgs
+	expectationsDotImportReturn                    []*ExampleMockDotImportReturnExpectation
+	callsSelfReferentialReturn                     []ExampleMockSelfReferentialReturnArgs
+	expectationsSelfReferentialReturn              []*ExampleMockSelfReferentialReturnExpectation
+	callsStructReturn                              []ExampleMockStructReturnArgs
+	expectationsStructReturn                       []*ExampleMockStructReturnExpectation
+	callsEmbeddedStructReturn                      []ExampleMockEmbeddedStructReturnArgs
+	expectationsEmbeddedStructReturn               []*ExampleMockEmbeddedStructReturnExpectation
+	callsEmptyInterfaceReturn                      []ExampleMockEmptyInterfaceReturnArgs
+	expectationsEmptyInterfaceReturn               []*ExampleMockEmptyInterfaceReturnExpectation
+	callsInterfaceReturn                           []ExampleMockInterfaceReturnArgs
+	expectationsInterfaceReturn                    []*ExampleMockInterfaceReturnExpectation
+	callsInterfaceVariadicFuncReturn               []ExampleMockInterfaceVariadicFuncReturnArgs
+	expectationsInterfaceVariadicFuncReturn        []*ExampleMockInterfaceVariadicFuncReturnExpectation
+	callsEmbeddedInterfaceReturn                   []ExampleMockEmbeddedInterfaceReturnArgs
+	expectationsEmbeddedInterfaceReturn            []*ExampleMockEmbeddedInterfaceReturnExpectation
+	callsChanReturn                                []ExampleMockChanReturnArgs
+	expectationsChanReturn                         []*ExampleMockChanReturnExpectation
+	callsMapReturn                                 []ExampleMockMapReturnArgs
+	expectationsMapReturn                          []*ExampleMockMapReturnExpectation
 }
 
 // Verify that *ExampleMock implements Example.
@@ -108,6 +233,9 @@ var _ Example = &ExampleMock{}
 // method that records the number of times it has been called.
 func (m *ExampleMock) NoParamsOrReturn() {
 	atomic.AddInt32(&m.NoParamsOrReturnCalled, 1)
+	if exp := m.recordNoParamsOrReturn(ExampleMockNoParamsOrReturnArgs{}); exp != nil {
+		return
+	}
 	if m.NoParamsOrReturnStub == nil {
 		if m.T != nil {
 			m.T.Error("NoParamsOrReturnStub is nil")
@@ -117,10 +245,122 @@ func (m *ExampleMock) NoParamsOrReturn() {
 	m.NoParamsOrReturnStub()
 }
 
+// ExampleMockNoParamsOrReturnArgs holds the arguments
+// of a call to ExampleMock.NoParamsOrReturn.
+type ExampleMockNoParamsOrReturnArgs struct {
+}
+
+func (args ExampleMockNoParamsOrReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockNoParamsOrReturnArgs, rather than of the mock, so that the params of NoParamsOrReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockNoParamsOrReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// NoParamsOrReturnCalls returns the arguments of each call
+// made to NoParamsOrReturn so far.
+func (m *ExampleMock) NoParamsOrReturnCalls() []ExampleMockNoParamsOrReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockNoParamsOrReturnArgs(nil), m.callsNoParamsOrReturn...)
+}
+
+// ExampleMockNoParamsOrReturnExpectation is an expected call
+// to ExampleMock.NoParamsOrReturn, registered with OnNoParamsOrReturn.
+type ExampleMockNoParamsOrReturnExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockNoParamsOrReturnExpectation) matches(args ExampleMockNoParamsOrReturnArgs) bool {
+	return true
+}
+
+// OnNoParamsOrReturn registers an expected call to NoParamsOrReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling NoParamsOrReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless NoParamsOrReturnStub is set.
+func (m *ExampleMock) OnNoParamsOrReturn() *ExampleMockNoParamsOrReturnExpectation {
+	return m.expectNoParamsOrReturn(&ExampleMockNoParamsOrReturnExpectation{
+		matchers: ExampleMockNoParamsOrReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectNoParamsOrReturn(exp *ExampleMockNoParamsOrReturnExpectation) *ExampleMockNoParamsOrReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsNoParamsOrReturn = append(m.expectationsNoParamsOrReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordNoParamsOrReturn(args ExampleMockNoParamsOrReturnArgs) *ExampleMockNoParamsOrReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsNoParamsOrReturn = append(m.callsNoParamsOrReturn, args)
+	for _, exp := range m.expectationsNoParamsOrReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsNoParamsOrReturn) > 0 && m.NoParamsOrReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsNoParamsOrReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("NoParamsOrReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertNoParamsOrReturnCalledWith fails the test unless NoParamsOrReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertNoParamsOrReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithNoParamsOrReturn(&ExampleMockNoParamsOrReturnExpectation{
+		matchers: ExampleMockNoParamsOrReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithNoParamsOrReturn(exp *ExampleMockNoParamsOrReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.NoParamsOrReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("NoParamsOrReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // UnnamedParam is a stub for the Example.UnnamedParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) UnnamedParam(param1 string) {
 	atomic.AddInt32(&m.UnnamedParamCalled, 1)
+	if exp := m.recordUnnamedParam(ExampleMockUnnamedParamArgs{Param1: param1}); exp != nil {
+		return
+	}
 	if m.UnnamedParamStub == nil {
 		if m.T != nil {
 			m.T.Error("UnnamedParamStub is nil")
@@ -130,10 +370,123 @@ func (m *ExampleMock) UnnamedParam(param1 string) {
 	m.UnnamedParamStub(param1)
 }
 
+// ExampleMockUnnamedParamArgs holds the arguments
+// of a call to ExampleMock.UnnamedParam.
+type ExampleMockUnnamedParamArgs struct {
+	Param1 string
+}
+
+func (args ExampleMockUnnamedParamArgs) call() match.Call {
+	return match.Call{args.Param1}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockUnnamedParamArgs, rather than of the mock, so that the params of UnnamedParam
+// can't shadow the match package or the params' types.
+func (ExampleMockUnnamedParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[string](vs[0])}
+}
+
+// UnnamedParamCalls returns the arguments of each call
+// made to UnnamedParam so far.
+func (m *ExampleMock) UnnamedParamCalls() []ExampleMockUnnamedParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockUnnamedParamArgs(nil), m.callsUnnamedParam...)
+}
+
+// ExampleMockUnnamedParamExpectation is an expected call
+// to ExampleMock.UnnamedParam, registered with OnUnnamedParam.
+type ExampleMockUnnamedParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockUnnamedParamExpectation) matches(args ExampleMockUnnamedParamArgs) bool {
+	return exp.matchers[0].Matches(args.Param1)
+}
+
+// OnUnnamedParam registers an expected call to UnnamedParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling UnnamedParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless UnnamedParamStub is set.
+func (m *ExampleMock) OnUnnamedParam(param1 any) *ExampleMockUnnamedParamExpectation {
+	return m.expectUnnamedParam(&ExampleMockUnnamedParamExpectation{
+		matchers: ExampleMockUnnamedParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) expectUnnamedParam(exp *ExampleMockUnnamedParamExpectation) *ExampleMockUnnamedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsUnnamedParam = append(m.expectationsUnnamedParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordUnnamedParam(args ExampleMockUnnamedParamArgs) *ExampleMockUnnamedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsUnnamedParam = append(m.callsUnnamedParam, args)
+	for _, exp := range m.expectationsUnnamedParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsUnnamedParam) > 0 && m.UnnamedParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsUnnamedParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("UnnamedParam", []string{"param1"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertUnnamedParamCalledWith fails the test unless UnnamedParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertUnnamedParamCalledWith(param1 any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithUnnamedParam(&ExampleMockUnnamedParamExpectation{
+		matchers: ExampleMockUnnamedParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithUnnamedParam(exp *ExampleMockUnnamedParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.UnnamedParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("UnnamedParam", []string{"param1"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // UnnamedVariadicParam is a stub for the Example.UnnamedVariadicParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) UnnamedVariadicParam(param1 ...string) {
 	atomic.AddInt32(&m.UnnamedVariadicParamCalled, 1)
+	if exp := m.recordUnnamedVariadicParam(ExampleMockUnnamedVariadicParamArgs{Param1: param1}); exp != nil {
+		return
+	}
 	if m.UnnamedVariadicParamStub == nil {
 		if m.T != nil {
 			m.T.Error("UnnamedVariadicParamStub is nil")
@@ -143,10 +496,123 @@ func (m *ExampleMock) UnnamedVariadicParam(param1 ...string) {
 	m.UnnamedVariadicParamStub(param1...)
 }
 
+// ExampleMockUnnamedVariadicParamArgs holds the arguments
+// of a call to ExampleMock.UnnamedVariadicParam.
+type ExampleMockUnnamedVariadicParamArgs struct {
+	Param1 []string
+}
+
+func (args ExampleMockUnnamedVariadicParamArgs) call() match.Call {
+	return match.Call{args.Param1}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockUnnamedVariadicParamArgs, rather than of the mock, so that the params of UnnamedVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockUnnamedVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]string](vs[0])}
+}
+
+// UnnamedVariadicParamCalls returns the arguments of each call
+// made to UnnamedVariadicParam so far.
+func (m *ExampleMock) UnnamedVariadicParamCalls() []ExampleMockUnnamedVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockUnnamedVariadicParamArgs(nil), m.callsUnnamedVariadicParam...)
+}
+
+// ExampleMockUnnamedVariadicParamExpectation is an expected call
+// to ExampleMock.UnnamedVariadicParam, registered with OnUnnamedVariadicParam.
+type ExampleMockUnnamedVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockUnnamedVariadicParamExpectation) matches(args ExampleMockUnnamedVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Param1)
+}
+
+// OnUnnamedVariadicParam registers an expected call to UnnamedVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling UnnamedVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless UnnamedVariadicParamStub is set.
+func (m *ExampleMock) OnUnnamedVariadicParam(param1 any) *ExampleMockUnnamedVariadicParamExpectation {
+	return m.expectUnnamedVariadicParam(&ExampleMockUnnamedVariadicParamExpectation{
+		matchers: ExampleMockUnnamedVariadicParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) expectUnnamedVariadicParam(exp *ExampleMockUnnamedVariadicParamExpectation) *ExampleMockUnnamedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsUnnamedVariadicParam = append(m.expectationsUnnamedVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordUnnamedVariadicParam(args ExampleMockUnnamedVariadicParamArgs) *ExampleMockUnnamedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsUnnamedVariadicParam = append(m.callsUnnamedVariadicParam, args)
+	for _, exp := range m.expectationsUnnamedVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsUnnamedVariadicParam) > 0 && m.UnnamedVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsUnnamedVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("UnnamedVariadicParam", []string{"param1"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertUnnamedVariadicParamCalledWith fails the test unless UnnamedVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertUnnamedVariadicParamCalledWith(param1 any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithUnnamedVariadicParam(&ExampleMockUnnamedVariadicParamExpectation{
+		matchers: ExampleMockUnnamedVariadicParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithUnnamedVariadicParam(exp *ExampleMockUnnamedVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.UnnamedVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("UnnamedVariadicParam", []string{"param1"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // BlankParam is a stub for the Example.BlankParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) BlankParam(param1 string) {
 	atomic.AddInt32(&m.BlankParamCalled, 1)
+	if exp := m.recordBlankParam(ExampleMockBlankParamArgs{Param1: param1}); exp != nil {
+		return
+	}
 	if m.BlankParamStub == nil {
 		if m.T != nil {
 			m.T.Error("BlankParamStub is nil")
@@ -156,10 +622,123 @@ func (m *ExampleMock) BlankParam(param1 string) {
 	m.BlankParamStub(param1)
 }
 
+// ExampleMockBlankParamArgs holds the arguments
+// of a call to ExampleMock.BlankParam.
+type ExampleMockBlankParamArgs struct {
+	Param1 string
+}
+
+func (args ExampleMockBlankParamArgs) call() match.Call {
+	return match.Call{args.Param1}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockBlankParamArgs, rather than of the mock, so that the params of BlankParam
+// can't shadow the match package or the params' types.
+func (ExampleMockBlankParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[string](vs[0])}
+}
+
+// BlankParamCalls returns the arguments of each call
+// made to BlankParam so far.
+func (m *ExampleMock) BlankParamCalls() []ExampleMockBlankParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockBlankParamArgs(nil), m.callsBlankParam...)
+}
+
+// ExampleMockBlankParamExpectation is an expected call
+// to ExampleMock.BlankParam, registered with OnBlankParam.
+type ExampleMockBlankParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockBlankParamExpectation) matches(args ExampleMockBlankParamArgs) bool {
+	return exp.matchers[0].Matches(args.Param1)
+}
+
+// OnBlankParam registers an expected call to BlankParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling BlankParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless BlankParamStub is set.
+func (m *ExampleMock) OnBlankParam(param1 any) *ExampleMockBlankParamExpectation {
+	return m.expectBlankParam(&ExampleMockBlankParamExpectation{
+		matchers: ExampleMockBlankParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) expectBlankParam(exp *ExampleMockBlankParamExpectation) *ExampleMockBlankParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsBlankParam = append(m.expectationsBlankParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordBlankParam(args ExampleMockBlankParamArgs) *ExampleMockBlankParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsBlankParam = append(m.callsBlankParam, args)
+	for _, exp := range m.expectationsBlankParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsBlankParam) > 0 && m.BlankParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsBlankParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("BlankParam", []string{"param1"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertBlankParamCalledWith fails the test unless BlankParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertBlankParamCalledWith(param1 any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithBlankParam(&ExampleMockBlankParamExpectation{
+		matchers: ExampleMockBlankParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithBlankParam(exp *ExampleMockBlankParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.BlankParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("BlankParam", []string{"param1"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // BlankVariadicParam is a stub for the Example.BlankVariadicParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) BlankVariadicParam(param1 ...string) {
 	atomic.AddInt32(&m.BlankVariadicParamCalled, 1)
+	if exp := m.recordBlankVariadicParam(ExampleMockBlankVariadicParamArgs{Param1: param1}); exp != nil {
+		return
+	}
 	if m.BlankVariadicParamStub == nil {
 		if m.T != nil {
 			m.T.Error("BlankVariadicParamStub is nil")
@@ -169,10 +748,123 @@ func (m *ExampleMock) BlankVariadicParam(param1 ...string) {
 	m.BlankVariadicParamStub(param1...)
 }
 
+// ExampleMockBlankVariadicParamArgs holds the arguments
+// of a call to ExampleMock.BlankVariadicParam.
+type ExampleMockBlankVariadicParamArgs struct {
+	Param1 []string
+}
+
+func (args ExampleMockBlankVariadicParamArgs) call() match.Call {
+	return match.Call{args.Param1}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockBlankVariadicParamArgs, rather than of the mock, so that the params of BlankVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockBlankVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]string](vs[0])}
+}
+
+// BlankVariadicParamCalls returns the arguments of each call
+// made to BlankVariadicParam so far.
+func (m *ExampleMock) BlankVariadicParamCalls() []ExampleMockBlankVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockBlankVariadicParamArgs(nil), m.callsBlankVariadicParam...)
+}
+
+// ExampleMockBlankVariadicParamExpectation is an expected call
+// to ExampleMock.BlankVariadicParam, registered with OnBlankVariadicParam.
+type ExampleMockBlankVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockBlankVariadicParamExpectation) matches(args ExampleMockBlankVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Param1)
+}
+
+// OnBlankVariadicParam registers an expected call to BlankVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling BlankVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless BlankVariadicParamStub is set.
+func (m *ExampleMock) OnBlankVariadicParam(param1 any) *ExampleMockBlankVariadicParamExpectation {
+	return m.expectBlankVariadicParam(&ExampleMockBlankVariadicParamExpectation{
+		matchers: ExampleMockBlankVariadicParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) expectBlankVariadicParam(exp *ExampleMockBlankVariadicParamExpectation) *ExampleMockBlankVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsBlankVariadicParam = append(m.expectationsBlankVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordBlankVariadicParam(args ExampleMockBlankVariadicParamArgs) *ExampleMockBlankVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsBlankVariadicParam = append(m.callsBlankVariadicParam, args)
+	for _, exp := range m.expectationsBlankVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsBlankVariadicParam) > 0 && m.BlankVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsBlankVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("BlankVariadicParam", []string{"param1"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertBlankVariadicParamCalledWith fails the test unless BlankVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertBlankVariadicParamCalledWith(param1 any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithBlankVariadicParam(&ExampleMockBlankVariadicParamExpectation{
+		matchers: ExampleMockBlankVariadicParamArgs{}.matchers(param1),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithBlankVariadicParam(exp *ExampleMockBlankVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.BlankVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("BlankVariadicParam", []string{"param1"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // NamedParam is a stub for the Example.NamedParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) NamedParam(str string) {
 	atomic.AddInt32(&m.NamedParamCalled, 1)
+	if exp := m.recordNamedParam(ExampleMockNamedParamArgs{Str: str}); exp != nil {
+		return
+	}
 	if m.NamedParamStub == nil {
 		if m.T != nil {
 			m.T.Error("NamedParamStub is nil")
@@ -182,10 +874,123 @@ func (m *ExampleMock) NamedParam(str string) {
 	m.NamedParamStub(str)
 }
 
+// ExampleMockNamedParamArgs holds the arguments
+// of a call to ExampleMock.NamedParam.
+type ExampleMockNamedParamArgs struct {
+	Str string
+}
+
+func (args ExampleMockNamedParamArgs) call() match.Call {
+	return match.Call{args.Str}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockNamedParamArgs, rather than of the mock, so that the params of NamedParam
+// can't shadow the match package or the params' types.
+func (ExampleMockNamedParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[string](vs[0])}
+}
+
+// NamedParamCalls returns the arguments of each call
+// made to NamedParam so far.
+func (m *ExampleMock) NamedParamCalls() []ExampleMockNamedParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockNamedParamArgs(nil), m.callsNamedParam...)
+}
+
+// ExampleMockNamedParamExpectation is an expected call
+// to ExampleMock.NamedParam, registered with OnNamedParam.
+type ExampleMockNamedParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockNamedParamExpectation) matches(args ExampleMockNamedParamArgs) bool {
+	return exp.matchers[0].Matches(args.Str)
+}
+
+// OnNamedParam registers an expected call to NamedParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling NamedParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless NamedParamStub is set.
+func (m *ExampleMock) OnNamedParam(str any) *ExampleMockNamedParamExpectation {
+	return m.expectNamedParam(&ExampleMockNamedParamExpectation{
+		matchers: ExampleMockNamedParamArgs{}.matchers(str),
+	})
+}
+
+func (m *ExampleMock) expectNamedParam(exp *ExampleMockNamedParamExpectation) *ExampleMockNamedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsNamedParam = append(m.expectationsNamedParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordNamedParam(args ExampleMockNamedParamArgs) *ExampleMockNamedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsNamedParam = append(m.callsNamedParam, args)
+	for _, exp := range m.expectationsNamedParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsNamedParam) > 0 && m.NamedParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsNamedParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("NamedParam", []string{"str"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertNamedParamCalledWith fails the test unless NamedParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertNamedParamCalledWith(str any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithNamedParam(&ExampleMockNamedParamExpectation{
+		matchers: ExampleMockNamedParamArgs{}.matchers(str),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithNamedParam(exp *ExampleMockNamedParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.NamedParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("NamedParam", []string{"str"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // NamedVariadicParam is a stub for the Example.NamedVariadicParam
 // method that records the number of times it has been called.
 func (m *ExampleMock) NamedVariadicParam(strs ...string) {
 	atomic.AddInt32(&m.NamedVariadicParamCalled, 1)
+	if exp := m.recordNamedVariadicParam(ExampleMockNamedVariadicParamArgs{Strs: strs}); exp != nil {
+		return
+	}
 	if m.NamedVariadicParamStub == nil {
 		if m.T != nil {
 			m.T.Error("NamedVariadicParamStub is nil")
@@ -195,10 +1000,123 @@ func (m *ExampleMock) NamedVariadicParam(strs ...string) {
 	m.NamedVariadicParamStub(strs...)
 }
 
+// ExampleMockNamedVariadicParamArgs holds the arguments
+// of a call to ExampleMock.NamedVariadicParam.
+type ExampleMockNamedVariadicParamArgs struct {
+	Strs []string
+}
+
+func (args ExampleMockNamedVariadicParamArgs) call() match.Call {
+	return match.Call{args.Strs}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockNamedVariadicParamArgs, rather than of the mock, so that the params of NamedVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockNamedVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]string](vs[0])}
+}
+
+// NamedVariadicParamCalls returns the arguments of each call
+// made to NamedVariadicParam so far.
+func (m *ExampleMock) NamedVariadicParamCalls() []ExampleMockNamedVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockNamedVariadicParamArgs(nil), m.callsNamedVariadicParam...)
+}
+
+// ExampleMockNamedVariadicParamExpectation is an expected call
+// to ExampleMock.NamedVariadicParam, registered with OnNamedVariadicParam.
+type ExampleMockNamedVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockNamedVariadicParamExpectation) matches(args ExampleMockNamedVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Strs)
+}
+
+// OnNamedVariadicParam registers an expected call to NamedVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling NamedVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless NamedVariadicParamStub is set.
+func (m *ExampleMock) OnNamedVariadicParam(strs any) *ExampleMockNamedVariadicParamExpectation {
+	return m.expectNamedVariadicParam(&ExampleMockNamedVariadicParamExpectation{
+		matchers: ExampleMockNamedVariadicParamArgs{}.matchers(strs),
+	})
+}
+
+func (m *ExampleMock) expectNamedVariadicParam(exp *ExampleMockNamedVariadicParamExpectation) *ExampleMockNamedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsNamedVariadicParam = append(m.expectationsNamedVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordNamedVariadicParam(args ExampleMockNamedVariadicParamArgs) *ExampleMockNamedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsNamedVariadicParam = append(m.callsNamedVariadicParam, args)
+	for _, exp := range m.expectationsNamedVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsNamedVariadicParam) > 0 && m.NamedVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsNamedVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("NamedVariadicParam", []string{"strs"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertNamedVariadicParamCalledWith fails the test unless NamedVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertNamedVariadicParamCalledWith(strs any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithNamedVariadicParam(&ExampleMockNamedVariadicParamExpectation{
+		matchers: ExampleMockNamedVariadicParamArgs{}.matchers(strs),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithNamedVariadicParam(exp *ExampleMockNamedVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.NamedVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("NamedVariadicParam", []string{"strs"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
 // SameTypeNamedParams is a stub for the Example.SameTypeNamedParams
 // method that records the number of times it has been called.
 func (m *ExampleMock) SameTypeNamedParams(str1 string, str2 string) {
 	atomic.AddInt32(&m.SameTypeNamedParamsCalled, 1)
+	if exp := m.recordSameTypeNamedParams(ExampleMockSameTypeNamedParamsArgs{Str1: str1, Str2: str2}); exp != nil {
+		return
+	}
 	if m.SameTypeNamedParamsStub == nil {
 		if m.T != nil {
 			m.T.Error("SameTypeNamedParamsStub is nil")
@@ -208,444 +1126,6772 @@ func (m *ExampleMock) SameTypeNamedParams(str1 string, str2 string) {
 	m.SameTypeNamedParamsStub(str1, str2)
 }
 
-// InternalTypeParam is a stub for the Example.InternalTypeParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) InternalTypeParam(internal internal.Internal) {
-	atomic.AddInt32(&m.InternalTypeParamCalled, 1)
-	if m.InternalTypeParamStub == nil {
-		if m.T != nil {
-			m.T.Error("InternalTypeParamStub is nil")
-		}
-		panic("InternalTypeParam unimplemented")
-	}
-	m.InternalTypeParamStub(internal)
+// ExampleMockSameTypeNamedParamsArgs holds the arguments
+// of a call to ExampleMock.SameTypeNamedParams.
+type ExampleMockSameTypeNamedParamsArgs struct {
+	Str1 string
+	Str2 string
 }
 
-// ImportedParam is a stub for the Example.ImportedParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) ImportedParam(tmpl template.Template) {
-	atomic.AddInt32(&m.ImportedParamCalled, 1)
-	if m.ImportedParamStub == nil {
-		if m.T != nil {
-			m.T.Error("ImportedParamStub is nil")
-		}
-		panic("ImportedParam unimplemented")
-	}
-	m.ImportedParamStub(tmpl)
+func (args ExampleMockSameTypeNamedParamsArgs) call() match.Call {
+	return match.Call{args.Str1, args.Str2}
 }
 
-// ImportedVariadicParam is a stub for the Example.ImportedVariadicParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) ImportedVariadicParam(tmpl ...template.Template) {
-	atomic.AddInt32(&m.ImportedVariadicParamCalled, 1)
-	if m.ImportedVariadicParamStub == nil {
-		if m.T != nil {
-			m.T.Error("ImportedVariadicParamStub is nil")
-		}
-		panic("ImportedVariadicParam unimplemented")
-	}
-	m.ImportedVariadicParamStub(tmpl...)
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockSameTypeNamedParamsArgs, rather than of the mock, so that the params of SameTypeNamedParams
+// can't shadow the match package or the params' types.
+func (ExampleMockSameTypeNamedParamsArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[string](vs[0]), match.OfType[string](vs[1])}
 }
 
-// RenamedImportParam is a stub for the Example.RenamedImportParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) RenamedImportParam(tmpl renamed.Template) {
-	atomic.AddInt32(&m.RenamedImportParamCalled, 1)
-	if m.RenamedImportParamStub == nil {
+// SameTypeNamedParamsCalls returns the arguments of each call
+// made to SameTypeNamedParams so far.
+func (m *ExampleMock) SameTypeNamedParamsCalls() []ExampleMockSameTypeNamedParamsArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockSameTypeNamedParamsArgs(nil), m.callsSameTypeNamedParams...)
+}
+
+// ExampleMockSameTypeNamedParamsExpectation is an expected call
+// to ExampleMock.SameTypeNamedParams, registered with OnSameTypeNamedParams.
+type ExampleMockSameTypeNamedParamsExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockSameTypeNamedParamsExpectation) matches(args ExampleMockSameTypeNamedParamsArgs) bool {
+	return exp.matchers[0].Matches(args.Str1) &&
+		exp.matchers[1].Matches(args.Str2)
+}
+
+// OnSameTypeNamedParams registers an expected call to SameTypeNamedParams, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling SameTypeNamedParamsStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless SameTypeNamedParamsStub is set.
+func (m *ExampleMock) OnSameTypeNamedParams(str1, str2 any) *ExampleMockSameTypeNamedParamsExpectation {
+	return m.expectSameTypeNamedParams(&ExampleMockSameTypeNamedParamsExpectation{
+		matchers: ExampleMockSameTypeNamedParamsArgs{}.matchers(str1, str2),
+	})
+}
+
+func (m *ExampleMock) expectSameTypeNamedParams(exp *ExampleMockSameTypeNamedParamsExpectation) *ExampleMockSameTypeNamedParamsExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsSameTypeNamedParams = append(m.expectationsSameTypeNamedParams, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordSameTypeNamedParams(args ExampleMockSameTypeNamedParamsArgs) *ExampleMockSameTypeNamedParamsExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsSameTypeNamedParams = append(m.callsSameTypeNamedParams, args)
+	for _, exp := range m.expectationsSameTypeNamedParams {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsSameTypeNamedParams) > 0 && m.SameTypeNamedParamsStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsSameTypeNamedParams {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("SameTypeNamedParams", []string{"str1", "str2"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("RenamedImportParamStub is nil")
+			m.T.Error(msg)
 		}
-		panic("RenamedImportParam unimplemented")
+		panic(msg)
 	}
-	m.RenamedImportParamStub(tmpl)
+	return nil
 }
 
-// RenamedImportVariadicParam is a stub for the Example.RenamedImportVariadicParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) RenamedImportVariadicParam(tmpls ...renamed.Template) {
-	atomic.AddInt32(&m.RenamedImportVariadicParamCalled, 1)
-	if m.RenamedImportVariadicParamStub == nil {
-		if m.T != nil {
-			m.T.Error("RenamedImportVariadicParamStub is nil")
-		}
-		panic("RenamedImportVariadicParam unimplemented")
+// AssertSameTypeNamedParamsCalledWith fails the test unless SameTypeNamedParams has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertSameTypeNamedParamsCalledWith(str1, str2 any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	m.RenamedImportVariadicParamStub(tmpls...)
+	return m.assertCalledWithSameTypeNamedParams(&ExampleMockSameTypeNamedParamsExpectation{
+		matchers: ExampleMockSameTypeNamedParamsArgs{}.matchers(str1, str2),
+	})
 }
 
-// DotImportParam is a stub for the Example.DotImportParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) DotImportParam(file File) {
-	atomic.AddInt32(&m.DotImportParamCalled, 1)
-	if m.DotImportParamStub == nil {
-		if m.T != nil {
-			m.T.Error("DotImportParamStub is nil")
+func (m *ExampleMock) assertCalledWithSameTypeNamedParams(exp *ExampleMockSameTypeNamedParamsExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.SameTypeNamedParamsCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("DotImportParam unimplemented")
+		calls = append(calls, args.call())
 	}
-	m.DotImportParamStub(file)
+	msg := match.NotCalledReport("SameTypeNamedParams", []string{"str1", "str2"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// DotImportVariadicParam is a stub for the Example.DotImportVariadicParam
+// InternalTypeParam is a stub for the Example.InternalTypeParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) DotImportVariadicParam(files ...File) {
-	atomic.AddInt32(&m.DotImportVariadicParamCalled, 1)
-	if m.DotImportVariadicParamStub == nil {
+func (m *ExampleMock) InternalTypeParam(internal internal.Internal) {
+	atomic.AddInt32(&m.InternalTypeParamCalled, 1)
+	if exp := m.recordInternalTypeParam(ExampleMockInternalTypeParamArgs{Internal: internal}); exp != nil {
+		return
+	}
+	if m.InternalTypeParamStub == nil {
 		if m.T != nil {
-			m.T.Error("DotImportVariadicParamStub is nil")
+			m.T.Error("InternalTypeParamStub is nil")
 		}
-		panic("DotImportVariadicParam unimplemented")
+		panic("InternalTypeParam unimplemented")
 	}
-	m.DotImportVariadicParamStub(files...)
+	m.InternalTypeParamStub(internal)
 }
 
-// SelfReferentialParam is a stub for the Example.SelfReferentialParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) SelfReferentialParam(intf Example) {
-	atomic.AddInt32(&m.SelfReferentialParamCalled, 1)
-	if m.SelfReferentialParamStub == nil {
+// ExampleMockInternalTypeParamArgs holds the arguments
+// of a call to ExampleMock.InternalTypeParam.
+type ExampleMockInternalTypeParamArgs struct {
+	Internal internal.Internal
+}
+
+func (args ExampleMockInternalTypeParamArgs) call() match.Call {
+	return match.Call{args.Internal}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInternalTypeParamArgs, rather than of the mock, so that the params of InternalTypeParam
+// can't shadow the match package or the params' types.
+func (ExampleMockInternalTypeParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[internal.Internal](vs[0])}
+}
+
+// InternalTypeParamCalls returns the arguments of each call
+// made to InternalTypeParam so far.
+func (m *ExampleMock) InternalTypeParamCalls() []ExampleMockInternalTypeParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInternalTypeParamArgs(nil), m.callsInternalTypeParam...)
+}
+
+// ExampleMockInternalTypeParamExpectation is an expected call
+// to ExampleMock.InternalTypeParam, registered with OnInternalTypeParam.
+type ExampleMockInternalTypeParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockInternalTypeParamExpectation) matches(args ExampleMockInternalTypeParamArgs) bool {
+	return exp.matchers[0].Matches(args.Internal)
+}
+
+// OnInternalTypeParam registers an expected call to InternalTypeParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InternalTypeParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InternalTypeParamStub is set.
+func (m *ExampleMock) OnInternalTypeParam(internal any) *ExampleMockInternalTypeParamExpectation {
+	return m.expectInternalTypeParam(&ExampleMockInternalTypeParamExpectation{
+		matchers: ExampleMockInternalTypeParamArgs{}.matchers(internal),
+	})
+}
+
+func (m *ExampleMock) expectInternalTypeParam(exp *ExampleMockInternalTypeParamExpectation) *ExampleMockInternalTypeParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInternalTypeParam = append(m.expectationsInternalTypeParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInternalTypeParam(args ExampleMockInternalTypeParamArgs) *ExampleMockInternalTypeParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInternalTypeParam = append(m.callsInternalTypeParam, args)
+	for _, exp := range m.expectationsInternalTypeParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInternalTypeParam) > 0 && m.InternalTypeParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInternalTypeParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InternalTypeParam", []string{"internal"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("SelfReferentialParamStub is nil")
+			m.T.Error(msg)
 		}
-		panic("SelfReferentialParam unimplemented")
+		panic(msg)
 	}
-	m.SelfReferentialParamStub(intf)
+	return nil
 }
 
-// SelfReferentialVariadicParam is a stub for the Example.SelfReferentialVariadicParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) SelfReferentialVariadicParam(intf ...Example) {
-	atomic.AddInt32(&m.SelfReferentialVariadicParamCalled, 1)
-	if m.SelfReferentialVariadicParamStub == nil {
-		if m.T != nil {
-			m.T.Error("SelfReferentialVariadicParamStub is nil")
-		}
-		panic("SelfReferentialVariadicParam unimplemented")
+// AssertInternalTypeParamCalledWith fails the test unless InternalTypeParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInternalTypeParamCalledWith(internal any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	m.SelfReferentialVariadicParamStub(intf...)
+	return m.assertCalledWithInternalTypeParam(&ExampleMockInternalTypeParamExpectation{
+		matchers: ExampleMockInternalTypeParamArgs{}.matchers(internal),
+	})
 }
 
-// StructParam is a stub for the Example.StructParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) StructParam(obj struct{ num int }) {
-	atomic.AddInt32(&m.StructParamCalled, 1)
-	if m.StructParamStub == nil {
-		if m.T != nil {
-			m.T.Error("StructParamStub is nil")
+func (m *ExampleMock) assertCalledWithInternalTypeParam(exp *ExampleMockInternalTypeParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InternalTypeParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("StructParam unimplemented")
+		calls = append(calls, args.call())
 	}
-	m.StructParamStub(obj)
+	msg := match.NotCalledReport("InternalTypeParam", []string{"internal"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// StructVariadicParam is a stub for the Example.StructVariadicParam
+// ImportedParam is a stub for the Example.ImportedParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) StructVariadicParam(objs ...struct{ num int }) {
-	atomic.AddInt32(&m.StructVariadicParamCalled, 1)
-	if m.StructVariadicParamStub == nil {
+func (m *ExampleMock) ImportedParam(tmpl template.Template) {
+	atomic.AddInt32(&m.ImportedParamCalled, 1)
+	if exp := m.recordImportedParam(ExampleMockImportedParamArgs{Tmpl: tmpl}); exp != nil {
+		return
+	}
+	if m.ImportedParamStub == nil {
 		if m.T != nil {
-			m.T.Error("StructVariadicParamStub is nil")
+			m.T.Error("ImportedParamStub is nil")
 		}
-		panic("StructVariadicParam unimplemented")
+		panic("ImportedParam unimplemented")
 	}
-	m.StructVariadicParamStub(objs...)
+	m.ImportedParamStub(tmpl)
 }
 
-// EmbeddedStructParam is a stub for the Example.EmbeddedStructParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmbeddedStructParam(obj struct{ int }) {
-	atomic.AddInt32(&m.EmbeddedStructParamCalled, 1)
-	if m.EmbeddedStructParamStub == nil {
+// ExampleMockImportedParamArgs holds the arguments
+// of a call to ExampleMock.ImportedParam.
+type ExampleMockImportedParamArgs struct {
+	Tmpl template.Template
+}
+
+func (args ExampleMockImportedParamArgs) call() match.Call {
+	return match.Call{args.Tmpl}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockImportedParamArgs, rather than of the mock, so that the params of ImportedParam
+// can't shadow the match package or the params' types.
+func (ExampleMockImportedParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[template.Template](vs[0])}
+}
+
+// ImportedParamCalls returns the arguments of each call
+// made to ImportedParam so far.
+func (m *ExampleMock) ImportedParamCalls() []ExampleMockImportedParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockImportedParamArgs(nil), m.callsImportedParam...)
+}
+
+// ExampleMockImportedParamExpectation is an expected call
+// to ExampleMock.ImportedParam, registered with OnImportedParam.
+type ExampleMockImportedParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockImportedParamExpectation) matches(args ExampleMockImportedParamArgs) bool {
+	return exp.matchers[0].Matches(args.Tmpl)
+}
+
+// OnImportedParam registers an expected call to ImportedParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ImportedParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ImportedParamStub is set.
+func (m *ExampleMock) OnImportedParam(tmpl any) *ExampleMockImportedParamExpectation {
+	return m.expectImportedParam(&ExampleMockImportedParamExpectation{
+		matchers: ExampleMockImportedParamArgs{}.matchers(tmpl),
+	})
+}
+
+func (m *ExampleMock) expectImportedParam(exp *ExampleMockImportedParamExpectation) *ExampleMockImportedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsImportedParam = append(m.expectationsImportedParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordImportedParam(args ExampleMockImportedParamArgs) *ExampleMockImportedParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsImportedParam = append(m.callsImportedParam, args)
+	for _, exp := range m.expectationsImportedParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsImportedParam) > 0 && m.ImportedParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsImportedParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("ImportedParam", []string{"tmpl"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("EmbeddedStructParamStub is nil")
+			m.T.Error(msg)
 		}
-		panic("EmbeddedStructParam unimplemented")
+		panic(msg)
 	}
-	m.EmbeddedStructParamStub(obj)
+	return nil
 }
 
-// EmbeddedStructVariadicParam is a stub for the Example.EmbeddedStructVariadicParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmbeddedStructVariadicParam(objs ...struct{ int }) {
-	atomic.AddInt32(&m.EmbeddedStructVariadicParamCalled, 1)
-	if m.EmbeddedStructVariadicParamStub == nil {
-		if m.T != nil {
-			m.T.Error("EmbeddedStructVariadicParamStub is nil")
-		}
-		panic("EmbeddedStructVariadicParam unimplemented")
+// AssertImportedParamCalledWith fails the test unless ImportedParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertImportedParamCalledWith(tmpl any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	m.EmbeddedStructVariadicParamStub(objs...)
+	return m.assertCalledWithImportedParam(&ExampleMockImportedParamExpectation{
+		matchers: ExampleMockImportedParamArgs{}.matchers(tmpl),
+	})
 }
 
-// EmptyInterfaceParam is a stub for the Example.EmptyInterfaceParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmptyInterfaceParam(intf interface{}) {
-	atomic.AddInt32(&m.EmptyInterfaceParamCalled, 1)
-	if m.EmptyInterfaceParamStub == nil {
-		if m.T != nil {
-			m.T.Error("EmptyInterfaceParamStub is nil")
+func (m *ExampleMock) assertCalledWithImportedParam(exp *ExampleMockImportedParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ImportedParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("EmptyInterfaceParam unimplemented")
+		calls = append(calls, args.call())
 	}
-	m.EmptyInterfaceParamStub(intf)
+	msg := match.NotCalledReport("ImportedParam", []string{"tmpl"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// EmptyInterfaceVariadicParam is a stub for the Example.EmptyInterfaceVariadicParam
+// ImportedVariadicParam is a stub for the Example.ImportedVariadicParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) EmptyInterfaceVariadicParam(intf ...interface{}) {
-	atomic.AddInt32(&m.EmptyInterfaceVariadicParamCalled, 1)
-	if m.EmptyInterfaceVariadicParamStub == nil {
+func (m *ExampleMock) ImportedVariadicParam(tmpl ...template.Template) {
+	atomic.AddInt32(&m.ImportedVariadicParamCalled, 1)
+	if exp := m.recordImportedVariadicParam(ExampleMockImportedVariadicParamArgs{Tmpl: tmpl}); exp != nil {
+		return
+	}
+	if m.ImportedVariadicParamStub == nil {
 		if m.T != nil {
-			m.T.Error("EmptyInterfaceVariadicParamStub is nil")
+			m.T.Error("ImportedVariadicParamStub is nil")
 		}
-		panic("EmptyInterfaceVariadicParam unimplemented")
+		panic("ImportedVariadicParam unimplemented")
 	}
-	m.EmptyInterfaceVariadicParamStub(intf...)
+	m.ImportedVariadicParamStub(tmpl...)
 }
 
-// InterfaceParam is a stub for the Example.InterfaceParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceParam(intf interface{ MyFunc(num int) error }) {
-	atomic.AddInt32(&m.InterfaceParamCalled, 1)
-	if m.InterfaceParamStub == nil {
+// ExampleMockImportedVariadicParamArgs holds the arguments
+// of a call to ExampleMock.ImportedVariadicParam.
+type ExampleMockImportedVariadicParamArgs struct {
+	Tmpl []template.Template
+}
+
+func (args ExampleMockImportedVariadicParamArgs) call() match.Call {
+	return match.Call{args.Tmpl}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockImportedVariadicParamArgs, rather than of the mock, so that the params of ImportedVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockImportedVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]template.Template](vs[0])}
+}
+
+// ImportedVariadicParamCalls returns the arguments of each call
+// made to ImportedVariadicParam so far.
+func (m *ExampleMock) ImportedVariadicParamCalls() []ExampleMockImportedVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockImportedVariadicParamArgs(nil), m.callsImportedVariadicParam...)
+}
+
+// ExampleMockImportedVariadicParamExpectation is an expected call
+// to ExampleMock.ImportedVariadicParam, registered with OnImportedVariadicParam.
+type ExampleMockImportedVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockImportedVariadicParamExpectation) matches(args ExampleMockImportedVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Tmpl)
+}
+
+// OnImportedVariadicParam registers an expected call to ImportedVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ImportedVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ImportedVariadicParamStub is set.
+func (m *ExampleMock) OnImportedVariadicParam(tmpl any) *ExampleMockImportedVariadicParamExpectation {
+	return m.expectImportedVariadicParam(&ExampleMockImportedVariadicParamExpectation{
+		matchers: ExampleMockImportedVariadicParamArgs{}.matchers(tmpl),
+	})
+}
+
+func (m *ExampleMock) expectImportedVariadicParam(exp *ExampleMockImportedVariadicParamExpectation) *ExampleMockImportedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsImportedVariadicParam = append(m.expectationsImportedVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordImportedVariadicParam(args ExampleMockImportedVariadicParamArgs) *ExampleMockImportedVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsImportedVariadicParam = append(m.callsImportedVariadicParam, args)
+	for _, exp := range m.expectationsImportedVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsImportedVariadicParam) > 0 && m.ImportedVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsImportedVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("ImportedVariadicParam", []string{"tmpl"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("InterfaceParamStub is nil")
+			m.T.Error(msg)
 		}
-		panic("InterfaceParam unimplemented")
+		panic(msg)
 	}
-	m.InterfaceParamStub(intf)
+	return nil
 }
 
-// InterfaceVariadicParam is a stub for the Example.InterfaceVariadicParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceVariadicParam(intf ...interface{ MyFunc(num int) error }) {
-	atomic.AddInt32(&m.InterfaceVariadicParamCalled, 1)
-	if m.InterfaceVariadicParamStub == nil {
-		if m.T != nil {
-			m.T.Error("InterfaceVariadicParamStub is nil")
-		}
-		panic("InterfaceVariadicParam unimplemented")
+// AssertImportedVariadicParamCalledWith fails the test unless ImportedVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertImportedVariadicParamCalledWith(tmpl any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	m.InterfaceVariadicParamStub(intf...)
+	return m.assertCalledWithImportedVariadicParam(&ExampleMockImportedVariadicParamExpectation{
+		matchers: ExampleMockImportedVariadicParamArgs{}.matchers(tmpl),
+	})
 }
 
-// InterfaceVariadicFuncParam is a stub for the Example.InterfaceVariadicFuncParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceVariadicFuncParam(intf interface{ MyFunc(nums ...int) error }) {
-	atomic.AddInt32(&m.InterfaceVariadicFuncParamCalled, 1)
-	if m.InterfaceVariadicFuncParamStub == nil {
-		if m.T != nil {
-			m.T.Error("InterfaceVariadicFuncParamStub is nil")
+func (m *ExampleMock) assertCalledWithImportedVariadicParam(exp *ExampleMockImportedVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ImportedVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("InterfaceVariadicFuncParam unimplemented")
+		calls = append(calls, args.call())
 	}
-	m.InterfaceVariadicFuncParamStub(intf)
+	msg := match.NotCalledReport("ImportedVariadicParam", []string{"tmpl"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// InterfaceVariadicFuncVariadicParam is a stub for the Example.InterfaceVariadicFuncVariadicParam
+// RenamedImportParam is a stub for the Example.RenamedImportParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceVariadicFuncVariadicParam(intf ...interface{ MyFunc(nums ...int) error }) {
-	atomic.AddInt32(&m.InterfaceVariadicFuncVariadicParamCalled, 1)
-	if m.InterfaceVariadicFuncVariadicParamStub == nil {
+func (m *ExampleMock) RenamedImportParam(tmpl renamed.Template) {
+	atomic.AddInt32(&m.RenamedImportParamCalled, 1)
+	if exp := m.recordRenamedImportParam(ExampleMockRenamedImportParamArgs{Tmpl: tmpl}); exp != nil {
+		return
+	}
+	if m.RenamedImportParamStub == nil {
 		if m.T != nil {
-			m.T.Error("InterfaceVariadicFuncVariadicParamStub is nil")
+			m.T.Error("RenamedImportParamStub is nil")
 		}
-		panic("InterfaceVariadicFuncVariadicParam unimplemented")
+		panic("RenamedImportParam unimplemented")
 	}
-	m.InterfaceVariadicFuncVariadicParamStub(intf...)
+	m.RenamedImportParamStub(tmpl)
 }
 
-// EmbeddedInterfaceParam is a stub for the Example.EmbeddedInterfaceParam
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmbeddedInterfaceParam(intf interface{ fmt.Stringer }) {
-	atomic.AddInt32(&m.EmbeddedInterfaceParamCalled, 1)
-	if m.EmbeddedInterfaceParamStub == nil {
+// ExampleMockRenamedImportParamArgs holds the arguments
+// of a call to ExampleMock.RenamedImportParam.
+type ExampleMockRenamedImportParamArgs struct {
+	Tmpl renamed.Template
+}
+
+func (args ExampleMockRenamedImportParamArgs) call() match.Call {
+	return match.Call{args.Tmpl}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockRenamedImportParamArgs, rather than of the mock, so that the params of RenamedImportParam
+// can't shadow the match package or the params' types.
+func (ExampleMockRenamedImportParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[renamed.Template](vs[0])}
+}
+
+// RenamedImportParamCalls returns the arguments of each call
+// made to RenamedImportParam so far.
+func (m *ExampleMock) RenamedImportParamCalls() []ExampleMockRenamedImportParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockRenamedImportParamArgs(nil), m.callsRenamedImportParam...)
+}
+
+// ExampleMockRenamedImportParamExpectation is an expected call
+// to ExampleMock.RenamedImportParam, registered with OnRenamedImportParam.
+type ExampleMockRenamedImportParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockRenamedImportParamExpectation) matches(args ExampleMockRenamedImportParamArgs) bool {
+	return exp.matchers[0].Matches(args.Tmpl)
+}
+
+// OnRenamedImportParam registers an expected call to RenamedImportParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling RenamedImportParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless RenamedImportParamStub is set.
+func (m *ExampleMock) OnRenamedImportParam(tmpl any) *ExampleMockRenamedImportParamExpectation {
+	return m.expectRenamedImportParam(&ExampleMockRenamedImportParamExpectation{
+		matchers: ExampleMockRenamedImportParamArgs{}.matchers(tmpl),
+	})
+}
+
+func (m *ExampleMock) expectRenamedImportParam(exp *ExampleMockRenamedImportParamExpectation) *ExampleMockRenamedImportParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsRenamedImportParam = append(m.expectationsRenamedImportParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordRenamedImportParam(args ExampleMockRenamedImportParamArgs) *ExampleMockRenamedImportParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsRenamedImportParam = append(m.callsRenamedImportParam, args)
+	for _, exp := range m.expectationsRenamedImportParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsRenamedImportParam) > 0 && m.RenamedImportParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsRenamedImportParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("RenamedImportParam", []string{"tmpl"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("EmbeddedInterfaceParamStub is nil")
+			m.T.Error(msg)
 		}
-		panic("EmbeddedInterfaceParam unimplemented")
+		panic(msg)
 	}
-	m.EmbeddedInterfaceParamStub(intf)
+	return nil
 }
 
-// UnnamedReturn is a stub for the Example.UnnamedReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) UnnamedReturn() error {
-	atomic.AddInt32(&m.UnnamedReturnCalled, 1)
-	if m.UnnamedReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("UnnamedReturnStub is nil")
-		}
-		panic("UnnamedReturn unimplemented")
+// AssertRenamedImportParamCalledWith fails the test unless RenamedImportParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertRenamedImportParamCalledWith(tmpl any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	return m.UnnamedReturnStub()
+	return m.assertCalledWithRenamedImportParam(&ExampleMockRenamedImportParamExpectation{
+		matchers: ExampleMockRenamedImportParamArgs{}.matchers(tmpl),
+	})
 }
 
-// MultipleUnnamedReturn is a stub for the Example.MultipleUnnamedReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) MultipleUnnamedReturn() (int, error) {
-	atomic.AddInt32(&m.MultipleUnnamedReturnCalled, 1)
-	if m.MultipleUnnamedReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("MultipleUnnamedReturnStub is nil")
+func (m *ExampleMock) assertCalledWithRenamedImportParam(exp *ExampleMockRenamedImportParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.RenamedImportParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("MultipleUnnamedReturn unimplemented")
+		calls = append(calls, args.call())
 	}
-	return m.MultipleUnnamedReturnStub()
+	msg := match.NotCalledReport("RenamedImportParam", []string{"tmpl"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// BlankReturn is a stub for the Example.BlankReturn
+// RenamedImportVariadicParam is a stub for the Example.RenamedImportVariadicParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) BlankReturn() (_ error) {
-	atomic.AddInt32(&m.BlankReturnCalled, 1)
-	if m.BlankReturnStub == nil {
+func (m *ExampleMock) RenamedImportVariadicParam(tmpls ...renamed.Template) {
+	atomic.AddInt32(&m.RenamedImportVariadicParamCalled, 1)
+	if exp := m.recordRenamedImportVariadicParam(ExampleMockRenamedImportVariadicParamArgs{Tmpls: tmpls}); exp != nil {
+		return
+	}
+	if m.RenamedImportVariadicParamStub == nil {
 		if m.T != nil {
-			m.T.Error("BlankReturnStub is nil")
+			m.T.Error("RenamedImportVariadicParamStub is nil")
 		}
-		panic("BlankReturn unimplemented")
+		panic("RenamedImportVariadicParam unimplemented")
 	}
-	return m.BlankReturnStub()
+	m.RenamedImportVariadicParamStub(tmpls...)
 }
 
-// NamedReturn is a stub for the Example.NamedReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) NamedReturn() (err error) {
-	atomic.AddInt32(&m.NamedReturnCalled, 1)
-	if m.NamedReturnStub == nil {
+// ExampleMockRenamedImportVariadicParamArgs holds the arguments
+// of a call to ExampleMock.RenamedImportVariadicParam.
+type ExampleMockRenamedImportVariadicParamArgs struct {
+	Tmpls []renamed.Template
+}
+
+func (args ExampleMockRenamedImportVariadicParamArgs) call() match.Call {
+	return match.Call{args.Tmpls}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockRenamedImportVariadicParamArgs, rather than of the mock, so that the params of RenamedImportVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockRenamedImportVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]renamed.Template](vs[0])}
+}
+
+// RenamedImportVariadicParamCalls returns the arguments of each call
+// made to RenamedImportVariadicParam so far.
+func (m *ExampleMock) RenamedImportVariadicParamCalls() []ExampleMockRenamedImportVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockRenamedImportVariadicParamArgs(nil), m.callsRenamedImportVariadicParam...)
+}
+
+// ExampleMockRenamedImportVariadicParamExpectation is an expected call
+// to ExampleMock.RenamedImportVariadicParam, registered with OnRenamedImportVariadicParam.
+type ExampleMockRenamedImportVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockRenamedImportVariadicParamExpectation) matches(args ExampleMockRenamedImportVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Tmpls)
+}
+
+// OnRenamedImportVariadicParam registers an expected call to RenamedImportVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling RenamedImportVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless RenamedImportVariadicParamStub is set.
+func (m *ExampleMock) OnRenamedImportVariadicParam(tmpls any) *ExampleMockRenamedImportVariadicParamExpectation {
+	return m.expectRenamedImportVariadicParam(&ExampleMockRenamedImportVariadicParamExpectation{
+		matchers: ExampleMockRenamedImportVariadicParamArgs{}.matchers(tmpls),
+	})
+}
+
+func (m *ExampleMock) expectRenamedImportVariadicParam(exp *ExampleMockRenamedImportVariadicParamExpectation) *ExampleMockRenamedImportVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsRenamedImportVariadicParam = append(m.expectationsRenamedImportVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordRenamedImportVariadicParam(args ExampleMockRenamedImportVariadicParamArgs) *ExampleMockRenamedImportVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsRenamedImportVariadicParam = append(m.callsRenamedImportVariadicParam, args)
+	for _, exp := range m.expectationsRenamedImportVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsRenamedImportVariadicParam) > 0 && m.RenamedImportVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsRenamedImportVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("RenamedImportVariadicParam", []string{"tmpls"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("NamedReturnStub is nil")
+			m.T.Error(msg)
 		}
-		panic("NamedReturn unimplemented")
+		panic(msg)
 	}
-	return m.NamedReturnStub()
+	return nil
 }
 
-// SameTypeNamedReturn is a stub for the Example.SameTypeNamedReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) SameTypeNamedReturn() (err1 error, err2 error) {
-	atomic.AddInt32(&m.SameTypeNamedReturnCalled, 1)
-	if m.SameTypeNamedReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("SameTypeNamedReturnStub is nil")
-		}
-		panic("SameTypeNamedReturn unimplemented")
+// AssertRenamedImportVariadicParamCalledWith fails the test unless RenamedImportVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertRenamedImportVariadicParamCalledWith(tmpls any) bool {
+	if m.T != nil {
+		m.T.Helper()
 	}
-	return m.SameTypeNamedReturnStub()
+	return m.assertCalledWithRenamedImportVariadicParam(&ExampleMockRenamedImportVariadicParamExpectation{
+		matchers: ExampleMockRenamedImportVariadicParamArgs{}.matchers(tmpls),
+	})
 }
 
-// RenamedImportReturn is a stub for the Example.RenamedImportReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) RenamedImportReturn() (tmpl renamed.Template) {
-	atomic.AddInt32(&m.RenamedImportReturnCalled, 1)
-	if m.RenamedImportReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("RenamedImportReturnStub is nil")
+func (m *ExampleMock) assertCalledWithRenamedImportVariadicParam(exp *ExampleMockRenamedImportVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.RenamedImportVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("RenamedImportReturn unimplemented")
+		calls = append(calls, args.call())
 	}
-	return m.RenamedImportReturnStub()
+	msg := match.NotCalledReport("RenamedImportVariadicParam", []string{"tmpls"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// DotImportReturn is a stub for the Example.DotImportReturn
+// DotImportParam is a stub for the Example.DotImportParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) DotImportReturn() (file File) {
-	atomic.AddInt32(&m.DotImportReturnCalled, 1)
-	if m.DotImportReturnStub == nil {
+func (m *ExampleMock) DotImportParam(file File) {
+	atomic.AddInt32(&m.DotImportParamCalled, 1)
+	if exp := m.recordDotImportParam(ExampleMockDotImportParamArgs{File: file}); exp != nil {
+		return
+	}
+	if m.DotImportParamStub == nil {
 		if m.T != nil {
-			m.T.Error("DotImportReturnStub is nil")
+			m.T.Error("DotImportParamStub is nil")
 		}
-		panic("DotImportReturn unimplemented")
+		panic("DotImportParam unimplemented")
 	}
-	return m.DotImportReturnStub()
+	m.DotImportParamStub(file)
 }
 
-// SelfReferentialReturn is a stub for the Example.SelfReferentialReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) SelfReferentialReturn() (intf Example) {
-	atomic.AddInt32(&m.SelfReferentialReturnCalled, 1)
-	if m.SelfReferentialReturnStub == nil {
+// ExampleMockDotImportParamArgs holds the arguments
+// of a call to ExampleMock.DotImportParam.
+type ExampleMockDotImportParamArgs struct {
+	File File
+}
+
+func (args ExampleMockDotImportParamArgs) call() match.Call {
+	return match.Call{args.File}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockDotImportParamArgs, rather than of the mock, so that the params of DotImportParam
+// can't shadow the match package or the params' types.
+func (ExampleMockDotImportParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[File](vs[0])}
+}
+
+// DotImportParamCalls returns the arguments of each call
+// made to DotImportParam so far.
+func (m *ExampleMock) DotImportParamCalls() []ExampleMockDotImportParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockDotImportParamArgs(nil), m.callsDotImportParam...)
+}
+
+// ExampleMockDotImportParamExpectation is an expected call
+// to ExampleMock.DotImportParam, registered with OnDotImportParam.
+type ExampleMockDotImportParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockDotImportParamExpectation) matches(args ExampleMockDotImportParamArgs) bool {
+	return exp.matchers[0].Matches(args.File)
+}
+
+// OnDotImportParam registers an expected call to DotImportParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling DotImportParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless DotImportParamStub is set.
+func (m *ExampleMock) OnDotImportParam(file any) *ExampleMockDotImportParamExpectation {
+	return m.expectDotImportParam(&ExampleMockDotImportParamExpectation{
+		matchers: ExampleMockDotImportParamArgs{}.matchers(file),
+	})
+}
+
+func (m *ExampleMock) expectDotImportParam(exp *ExampleMockDotImportParamExpectation) *ExampleMockDotImportParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsDotImportParam = append(m.expectationsDotImportParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordDotImportParam(args ExampleMockDotImportParamArgs) *ExampleMockDotImportParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsDotImportParam = append(m.callsDotImportParam, args)
+	for _, exp := range m.expectationsDotImportParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsDotImportParam) > 0 && m.DotImportParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsDotImportParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("DotImportParam", []string{"file"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("SelfReferentialReturnStub is nil")
+			m.T.Error(msg)
 		}
-		panic("SelfReferentialReturn unimplemented")
+		panic(msg)
 	}
-	return m.SelfReferentialReturnStub()
+	return nil
 }
 
-// StructReturn is a stub for the Example.StructReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) StructReturn() (obj struct{ num int }) {
-	atomic.AddInt32(&m.StructReturnCalled, 1)
-	if m.StructReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("StructReturnStub is nil")
+// AssertDotImportParamCalledWith fails the test unless DotImportParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertDotImportParamCalledWith(file any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithDotImportParam(&ExampleMockDotImportParamExpectation{
+		matchers: ExampleMockDotImportParamArgs{}.matchers(file),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithDotImportParam(exp *ExampleMockDotImportParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.DotImportParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("StructReturn unimplemented")
+		calls = append(calls, args.call())
 	}
-	return m.StructReturnStub()
+	msg := match.NotCalledReport("DotImportParam", []string{"file"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// EmbeddedStructReturn is a stub for the Example.EmbeddedStructReturn
+// DotImportVariadicParam is a stub for the Example.DotImportVariadicParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) EmbeddedStructReturn() (obj struct{ int }) {
-	atomic.AddInt32(&m.EmbeddedStructReturnCalled, 1)
-	if m.EmbeddedStructReturnStub == nil {
+func (m *ExampleMock) DotImportVariadicParam(files ...File) {
+	atomic.AddInt32(&m.DotImportVariadicParamCalled, 1)
+	if exp := m.recordDotImportVariadicParam(ExampleMockDotImportVariadicParamArgs{Files: files}); exp != nil {
+		return
+	}
+	if m.DotImportVariadicParamStub == nil {
 		if m.T != nil {
-			m.T.Error("EmbeddedStructReturnStub is nil")
+			m.T.Error("DotImportVariadicParamStub is nil")
 		}
-		panic("EmbeddedStructReturn unimplemented")
+		panic("DotImportVariadicParam unimplemented")
 	}
-	return m.EmbeddedStructReturnStub()
+	m.DotImportVariadicParamStub(files...)
 }
 
-// EmptyInterfaceReturn is a stub for the Example.EmptyInterfaceReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmptyInterfaceReturn() (intf interface{}) {
-	atomic.AddInt32(&m.EmptyInterfaceReturnCalled, 1)
-	if m.EmptyInterfaceReturnStub == nil {
+// ExampleMockDotImportVariadicParamArgs holds the arguments
+// of a call to ExampleMock.DotImportVariadicParam.
+type ExampleMockDotImportVariadicParamArgs struct {
+	Files []File
+}
+
+func (args ExampleMockDotImportVariadicParamArgs) call() match.Call {
+	return match.Call{args.Files}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockDotImportVariadicParamArgs, rather than of the mock, so that the params of DotImportVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockDotImportVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]File](vs[0])}
+}
+
+// DotImportVariadicParamCalls returns the arguments of each call
+// made to DotImportVariadicParam so far.
+func (m *ExampleMock) DotImportVariadicParamCalls() []ExampleMockDotImportVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockDotImportVariadicParamArgs(nil), m.callsDotImportVariadicParam...)
+}
+
+// ExampleMockDotImportVariadicParamExpectation is an expected call
+// to ExampleMock.DotImportVariadicParam, registered with OnDotImportVariadicParam.
+type ExampleMockDotImportVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockDotImportVariadicParamExpectation) matches(args ExampleMockDotImportVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Files)
+}
+
+// OnDotImportVariadicParam registers an expected call to DotImportVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling DotImportVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless DotImportVariadicParamStub is set.
+func (m *ExampleMock) OnDotImportVariadicParam(files any) *ExampleMockDotImportVariadicParamExpectation {
+	return m.expectDotImportVariadicParam(&ExampleMockDotImportVariadicParamExpectation{
+		matchers: ExampleMockDotImportVariadicParamArgs{}.matchers(files),
+	})
+}
+
+func (m *ExampleMock) expectDotImportVariadicParam(exp *ExampleMockDotImportVariadicParamExpectation) *ExampleMockDotImportVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsDotImportVariadicParam = append(m.expectationsDotImportVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordDotImportVariadicParam(args ExampleMockDotImportVariadicParamArgs) *ExampleMockDotImportVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsDotImportVariadicParam = append(m.callsDotImportVariadicParam, args)
+	for _, exp := range m.expectationsDotImportVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsDotImportVariadicParam) > 0 && m.DotImportVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsDotImportVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("DotImportVariadicParam", []string{"files"}, args.call(), expectations)
 		if m.T != nil {
-			m.T.Error("EmptyInterfaceReturnStub is nil")
+			m.T.Error(msg)
 		}
-		panic("EmptyInterfaceReturn unimplemented")
+		panic(msg)
 	}
-	return m.EmptyInterfaceReturnStub()
+	return nil
 }
 
-// InterfaceReturn is a stub for the Example.InterfaceReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceReturn() (intf interface{ MyFunc(num int) error }) {
-	atomic.AddInt32(&m.InterfaceReturnCalled, 1)
-	if m.InterfaceReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("InterfaceReturnStub is nil")
+// AssertDotImportVariadicParamCalledWith fails the test unless DotImportVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertDotImportVariadicParamCalledWith(files any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithDotImportVariadicParam(&ExampleMockDotImportVariadicParamExpectation{
+		matchers: ExampleMockDotImportVariadicParamArgs{}.matchers(files),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithDotImportVariadicParam(exp *ExampleMockDotImportVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.DotImportVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
 		}
-		panic("InterfaceReturn unimplemented")
+		calls = append(calls, args.call())
 	}
-	return m.InterfaceReturnStub()
+	msg := match.NotCalledReport("DotImportVariadicParam", []string{"files"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
 }
 
-// InterfaceVariadicFuncReturn is a stub for the Example.InterfaceVariadicFuncReturn
+// SelfReferentialParam is a stub for the Example.SelfReferentialParam
 // method that records the number of times it has been called.
-func (m *ExampleMock) InterfaceVariadicFuncReturn() (intf interface{ MyFunc(nums ...int) error }) {
-	atomic.AddInt32(&m.InterfaceVariadicFuncReturnCalled, 1)
-	if m.InterfaceVariadicFuncReturnStub == nil {
+func (m *ExampleMock) SelfReferentialParam(intf Example) {
+	atomic.AddInt32(&m.SelfReferentialParamCalled, 1)
+	if exp := m.recordSelfReferentialParam(ExampleMockSelfReferentialParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.SelfReferentialParamStub == nil {
 		if m.T != nil {
-			m.T.Error("InterfaceVariadicFuncReturnStub is nil")
+			m.T.Error("SelfReferentialParamStub is nil")
 		}
-		panic("InterfaceVariadicFuncReturn unimplemented")
+		panic("SelfReferentialParam unimplemented")
 	}
-	return m.InterfaceVariadicFuncReturnStub()
+	m.SelfReferentialParamStub(intf)
 }
 
-// EmbeddedInterfaceReturn is a stub for the Example.EmbeddedInterfaceReturn
-// method that records the number of times it has been called.
-func (m *ExampleMock) EmbeddedInterfaceReturn() (intf interface{ fmt.Stringer }) {
-	atomic.AddInt32(&m.EmbeddedInterfaceReturnCalled, 1)
-	if m.EmbeddedInterfaceReturnStub == nil {
-		if m.T != nil {
-			m.T.Error("EmbeddedInterfaceReturnStub is nil")
+// ExampleMockSelfReferentialParamArgs holds the arguments
+// of a call to ExampleMock.SelfReferentialParam.
+type ExampleMockSelfReferentialParamArgs struct {
+	Intf Example
+}
+
+func (args ExampleMockSelfReferentialParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockSelfReferentialParamArgs, rather than of the mock, so that the params of SelfReferentialParam
+// can't shadow the match package or the params' types.
+func (ExampleMockSelfReferentialParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[Example](vs[0])}
+}
+
+// SelfReferentialParamCalls returns the arguments of each call
+// made to SelfReferentialParam so far.
+func (m *ExampleMock) SelfReferentialParamCalls() []ExampleMockSelfReferentialParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockSelfReferentialParamArgs(nil), m.callsSelfReferentialParam...)
+}
+
+// ExampleMockSelfReferentialParamExpectation is an expected call
+// to ExampleMock.SelfReferentialParam, registered with OnSelfReferentialParam.
+type ExampleMockSelfReferentialParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockSelfReferentialParamExpectation) matches(args ExampleMockSelfReferentialParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnSelfReferentialParam registers an expected call to SelfReferentialParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling SelfReferentialParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless SelfReferentialParamStub is set.
+func (m *ExampleMock) OnSelfReferentialParam(intf any) *ExampleMockSelfReferentialParamExpectation {
+	return m.expectSelfReferentialParam(&ExampleMockSelfReferentialParamExpectation{
+		matchers: ExampleMockSelfReferentialParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectSelfReferentialParam(exp *ExampleMockSelfReferentialParamExpectation) *ExampleMockSelfReferentialParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsSelfReferentialParam = append(m.expectationsSelfReferentialParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordSelfReferentialParam(args ExampleMockSelfReferentialParamArgs) *ExampleMockSelfReferentialParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsSelfReferentialParam = append(m.callsSelfReferentialParam, args)
+	for _, exp := range m.expectationsSelfReferentialParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
 		}
-		panic("EmbeddedInterfaceReturn unimplemented")
 	}
-	return m.EmbeddedInterfaceReturnStub()
+	if len(m.expectationsSelfReferentialParam) > 0 && m.SelfReferentialParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsSelfReferentialParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("SelfReferentialParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertSelfReferentialParamCalledWith fails the test unless SelfReferentialParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertSelfReferentialParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithSelfReferentialParam(&ExampleMockSelfReferentialParamExpectation{
+		matchers: ExampleMockSelfReferentialParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithSelfReferentialParam(exp *ExampleMockSelfReferentialParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.SelfReferentialParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("SelfReferentialParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// SelfReferentialVariadicParam is a stub for the Example.SelfReferentialVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) SelfReferentialVariadicParam(intf ...Example) {
+	atomic.AddInt32(&m.SelfReferentialVariadicParamCalled, 1)
+	if exp := m.recordSelfReferentialVariadicParam(ExampleMockSelfReferentialVariadicParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.SelfReferentialVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("SelfReferentialVariadicParamStub is nil")
+		}
+		panic("SelfReferentialVariadicParam unimplemented")
+	}
+	m.SelfReferentialVariadicParamStub(intf...)
+}
+
+// ExampleMockSelfReferentialVariadicParamArgs holds the arguments
+// of a call to ExampleMock.SelfReferentialVariadicParam.
+type ExampleMockSelfReferentialVariadicParamArgs struct {
+	Intf []Example
+}
+
+func (args ExampleMockSelfReferentialVariadicParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockSelfReferentialVariadicParamArgs, rather than of the mock, so that the params of SelfReferentialVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockSelfReferentialVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]Example](vs[0])}
+}
+
+// SelfReferentialVariadicParamCalls returns the arguments of each call
+// made to SelfReferentialVariadicParam so far.
+func (m *ExampleMock) SelfReferentialVariadicParamCalls() []ExampleMockSelfReferentialVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockSelfReferentialVariadicParamArgs(nil), m.callsSelfReferentialVariadicParam...)
+}
+
+// ExampleMockSelfReferentialVariadicParamExpectation is an expected call
+// to ExampleMock.SelfReferentialVariadicParam, registered with OnSelfReferentialVariadicParam.
+type ExampleMockSelfReferentialVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockSelfReferentialVariadicParamExpectation) matches(args ExampleMockSelfReferentialVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnSelfReferentialVariadicParam registers an expected call to SelfReferentialVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling SelfReferentialVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless SelfReferentialVariadicParamStub is set.
+func (m *ExampleMock) OnSelfReferentialVariadicParam(intf any) *ExampleMockSelfReferentialVariadicParamExpectation {
+	return m.expectSelfReferentialVariadicParam(&ExampleMockSelfReferentialVariadicParamExpectation{
+		matchers: ExampleMockSelfReferentialVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectSelfReferentialVariadicParam(exp *ExampleMockSelfReferentialVariadicParamExpectation) *ExampleMockSelfReferentialVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsSelfReferentialVariadicParam = append(m.expectationsSelfReferentialVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordSelfReferentialVariadicParam(args ExampleMockSelfReferentialVariadicParamArgs) *ExampleMockSelfReferentialVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsSelfReferentialVariadicParam = append(m.callsSelfReferentialVariadicParam, args)
+	for _, exp := range m.expectationsSelfReferentialVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsSelfReferentialVariadicParam) > 0 && m.SelfReferentialVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsSelfReferentialVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("SelfReferentialVariadicParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertSelfReferentialVariadicParamCalledWith fails the test unless SelfReferentialVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertSelfReferentialVariadicParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithSelfReferentialVariadicParam(&ExampleMockSelfReferentialVariadicParamExpectation{
+		matchers: ExampleMockSelfReferentialVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithSelfReferentialVariadicParam(exp *ExampleMockSelfReferentialVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.SelfReferentialVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("SelfReferentialVariadicParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StructParam is a stub for the Example.StructParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) StructParam(obj struct{ num int }) {
+	atomic.AddInt32(&m.StructParamCalled, 1)
+	if exp := m.recordStructParam(ExampleMockStructParamArgs{Obj: obj}); exp != nil {
+		return
+	}
+	if m.StructParamStub == nil {
+		if m.T != nil {
+			m.T.Error("StructParamStub is nil")
+		}
+		panic("StructParam unimplemented")
+	}
+	m.StructParamStub(obj)
+}
+
+// ExampleMockStructParamArgs holds the arguments
+// of a call to ExampleMock.StructParam.
+type ExampleMockStructParamArgs struct {
+	Obj struct{ num int }
+}
+
+func (args ExampleMockStructParamArgs) call() match.Call {
+	return match.Call{args.Obj}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockStructParamArgs, rather than of the mock, so that the params of StructParam
+// can't shadow the match package or the params' types.
+func (ExampleMockStructParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[struct{ num int }](vs[0])}
+}
+
+// StructParamCalls returns the arguments of each call
+// made to StructParam so far.
+func (m *ExampleMock) StructParamCalls() []ExampleMockStructParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockStructParamArgs(nil), m.callsStructParam...)
+}
+
+// ExampleMockStructParamExpectation is an expected call
+// to ExampleMock.StructParam, registered with OnStructParam.
+type ExampleMockStructParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockStructParamExpectation) matches(args ExampleMockStructParamArgs) bool {
+	return exp.matchers[0].Matches(args.Obj)
+}
+
+// OnStructParam registers an expected call to StructParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling StructParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless StructParamStub is set.
+func (m *ExampleMock) OnStructParam(obj any) *ExampleMockStructParamExpectation {
+	return m.expectStructParam(&ExampleMockStructParamExpectation{
+		matchers: ExampleMockStructParamArgs{}.matchers(obj),
+	})
+}
+
+func (m *ExampleMock) expectStructParam(exp *ExampleMockStructParamExpectation) *ExampleMockStructParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsStructParam = append(m.expectationsStructParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordStructParam(args ExampleMockStructParamArgs) *ExampleMockStructParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsStructParam = append(m.callsStructParam, args)
+	for _, exp := range m.expectationsStructParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsStructParam) > 0 && m.StructParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsStructParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("StructParam", []string{"obj"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertStructParamCalledWith fails the test unless StructParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertStructParamCalledWith(obj any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithStructParam(&ExampleMockStructParamExpectation{
+		matchers: ExampleMockStructParamArgs{}.matchers(obj),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithStructParam(exp *ExampleMockStructParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.StructParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("StructParam", []string{"obj"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StructVariadicParam is a stub for the Example.StructVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) StructVariadicParam(objs ...struct{ num int }) {
+	atomic.AddInt32(&m.StructVariadicParamCalled, 1)
+	if exp := m.recordStructVariadicParam(ExampleMockStructVariadicParamArgs{Objs: objs}); exp != nil {
+		return
+	}
+	if m.StructVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("StructVariadicParamStub is nil")
+		}
+		panic("StructVariadicParam unimplemented")
+	}
+	m.StructVariadicParamStub(objs...)
+}
+
+// ExampleMockStructVariadicParamArgs holds the arguments
+// of a call to ExampleMock.StructVariadicParam.
+type ExampleMockStructVariadicParamArgs struct {
+	Objs []struct{ num int }
+}
+
+func (args ExampleMockStructVariadicParamArgs) call() match.Call {
+	return match.Call{args.Objs}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockStructVariadicParamArgs, rather than of the mock, so that the params of StructVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockStructVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]struct{ num int }](vs[0])}
+}
+
+// StructVariadicParamCalls returns the arguments of each call
+// made to StructVariadicParam so far.
+func (m *ExampleMock) StructVariadicParamCalls() []ExampleMockStructVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockStructVariadicParamArgs(nil), m.callsStructVariadicParam...)
+}
+
+// ExampleMockStructVariadicParamExpectation is an expected call
+// to ExampleMock.StructVariadicParam, registered with OnStructVariadicParam.
+type ExampleMockStructVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockStructVariadicParamExpectation) matches(args ExampleMockStructVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Objs)
+}
+
+// OnStructVariadicParam registers an expected call to StructVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling StructVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless StructVariadicParamStub is set.
+func (m *ExampleMock) OnStructVariadicParam(objs any) *ExampleMockStructVariadicParamExpectation {
+	return m.expectStructVariadicParam(&ExampleMockStructVariadicParamExpectation{
+		matchers: ExampleMockStructVariadicParamArgs{}.matchers(objs),
+	})
+}
+
+func (m *ExampleMock) expectStructVariadicParam(exp *ExampleMockStructVariadicParamExpectation) *ExampleMockStructVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsStructVariadicParam = append(m.expectationsStructVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordStructVariadicParam(args ExampleMockStructVariadicParamArgs) *ExampleMockStructVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsStructVariadicParam = append(m.callsStructVariadicParam, args)
+	for _, exp := range m.expectationsStructVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsStructVariadicParam) > 0 && m.StructVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsStructVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("StructVariadicParam", []string{"objs"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertStructVariadicParamCalledWith fails the test unless StructVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertStructVariadicParamCalledWith(objs any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithStructVariadicParam(&ExampleMockStructVariadicParamExpectation{
+		matchers: ExampleMockStructVariadicParamArgs{}.matchers(objs),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithStructVariadicParam(exp *ExampleMockStructVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.StructVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("StructVariadicParam", []string{"objs"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// EmbeddedStructParam is a stub for the Example.EmbeddedStructParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmbeddedStructParam(obj struct{ int }) {
+	atomic.AddInt32(&m.EmbeddedStructParamCalled, 1)
+	if exp := m.recordEmbeddedStructParam(ExampleMockEmbeddedStructParamArgs{Obj: obj}); exp != nil {
+		return
+	}
+	if m.EmbeddedStructParamStub == nil {
+		if m.T != nil {
+			m.T.Error("EmbeddedStructParamStub is nil")
+		}
+		panic("EmbeddedStructParam unimplemented")
+	}
+	m.EmbeddedStructParamStub(obj)
+}
+
+// ExampleMockEmbeddedStructParamArgs holds the arguments
+// of a call to ExampleMock.EmbeddedStructParam.
+type ExampleMockEmbeddedStructParamArgs struct {
+	Obj struct{ int }
+}
+
+func (args ExampleMockEmbeddedStructParamArgs) call() match.Call {
+	return match.Call{args.Obj}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmbeddedStructParamArgs, rather than of the mock, so that the params of EmbeddedStructParam
+// can't shadow the match package or the params' types.
+func (ExampleMockEmbeddedStructParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[struct{ int }](vs[0])}
+}
+
+// EmbeddedStructParamCalls returns the arguments of each call
+// made to EmbeddedStructParam so far.
+func (m *ExampleMock) EmbeddedStructParamCalls() []ExampleMockEmbeddedStructParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmbeddedStructParamArgs(nil), m.callsEmbeddedStructParam...)
+}
+
+// ExampleMockEmbeddedStructParamExpectation is an expected call
+// to ExampleMock.EmbeddedStructParam, registered with OnEmbeddedStructParam.
+type ExampleMockEmbeddedStructParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockEmbeddedStructParamExpectation) matches(args ExampleMockEmbeddedStructParamArgs) bool {
+	return exp.matchers[0].Matches(args.Obj)
+}
+
+// OnEmbeddedStructParam registers an expected call to EmbeddedStructParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmbeddedStructParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmbeddedStructParamStub is set.
+func (m *ExampleMock) OnEmbeddedStructParam(obj any) *ExampleMockEmbeddedStructParamExpectation {
+	return m.expectEmbeddedStructParam(&ExampleMockEmbeddedStructParamExpectation{
+		matchers: ExampleMockEmbeddedStructParamArgs{}.matchers(obj),
+	})
+}
+
+func (m *ExampleMock) expectEmbeddedStructParam(exp *ExampleMockEmbeddedStructParamExpectation) *ExampleMockEmbeddedStructParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmbeddedStructParam = append(m.expectationsEmbeddedStructParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmbeddedStructParam(args ExampleMockEmbeddedStructParamArgs) *ExampleMockEmbeddedStructParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmbeddedStructParam = append(m.callsEmbeddedStructParam, args)
+	for _, exp := range m.expectationsEmbeddedStructParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmbeddedStructParam) > 0 && m.EmbeddedStructParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmbeddedStructParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmbeddedStructParam", []string{"obj"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmbeddedStructParamCalledWith fails the test unless EmbeddedStructParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmbeddedStructParamCalledWith(obj any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmbeddedStructParam(&ExampleMockEmbeddedStructParamExpectation{
+		matchers: ExampleMockEmbeddedStructParamArgs{}.matchers(obj),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmbeddedStructParam(exp *ExampleMockEmbeddedStructParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmbeddedStructParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmbeddedStructParam", []string{"obj"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// EmbeddedStructVariadicParam is a stub for the Example.EmbeddedStructVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmbeddedStructVariadicParam(objs ...struct{ int }) {
+	atomic.AddInt32(&m.EmbeddedStructVariadicParamCalled, 1)
+	if exp := m.recordEmbeddedStructVariadicParam(ExampleMockEmbeddedStructVariadicParamArgs{Objs: objs}); exp != nil {
+		return
+	}
+	if m.EmbeddedStructVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("EmbeddedStructVariadicParamStub is nil")
+		}
+		panic("EmbeddedStructVariadicParam unimplemented")
+	}
+	m.EmbeddedStructVariadicParamStub(objs...)
+}
+
+// ExampleMockEmbeddedStructVariadicParamArgs holds the arguments
+// of a call to ExampleMock.EmbeddedStructVariadicParam.
+type ExampleMockEmbeddedStructVariadicParamArgs struct {
+	Objs []struct{ int }
+}
+
+func (args ExampleMockEmbeddedStructVariadicParamArgs) call() match.Call {
+	return match.Call{args.Objs}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmbeddedStructVariadicParamArgs, rather than of the mock, so that the params of EmbeddedStructVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockEmbeddedStructVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]struct{ int }](vs[0])}
+}
+
+// EmbeddedStructVariadicParamCalls returns the arguments of each call
+// made to EmbeddedStructVariadicParam so far.
+func (m *ExampleMock) EmbeddedStructVariadicParamCalls() []ExampleMockEmbeddedStructVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmbeddedStructVariadicParamArgs(nil), m.callsEmbeddedStructVariadicParam...)
+}
+
+// ExampleMockEmbeddedStructVariadicParamExpectation is an expected call
+// to ExampleMock.EmbeddedStructVariadicParam, registered with OnEmbeddedStructVariadicParam.
+type ExampleMockEmbeddedStructVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockEmbeddedStructVariadicParamExpectation) matches(args ExampleMockEmbeddedStructVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Objs)
+}
+
+// OnEmbeddedStructVariadicParam registers an expected call to EmbeddedStructVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmbeddedStructVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmbeddedStructVariadicParamStub is set.
+func (m *ExampleMock) OnEmbeddedStructVariadicParam(objs any) *ExampleMockEmbeddedStructVariadicParamExpectation {
+	return m.expectEmbeddedStructVariadicParam(&ExampleMockEmbeddedStructVariadicParamExpectation{
+		matchers: ExampleMockEmbeddedStructVariadicParamArgs{}.matchers(objs),
+	})
+}
+
+func (m *ExampleMock) expectEmbeddedStructVariadicParam(exp *ExampleMockEmbeddedStructVariadicParamExpectation) *ExampleMockEmbeddedStructVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmbeddedStructVariadicParam = append(m.expectationsEmbeddedStructVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmbeddedStructVariadicParam(args ExampleMockEmbeddedStructVariadicParamArgs) *ExampleMockEmbeddedStructVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmbeddedStructVariadicParam = append(m.callsEmbeddedStructVariadicParam, args)
+	for _, exp := range m.expectationsEmbeddedStructVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmbeddedStructVariadicParam) > 0 && m.EmbeddedStructVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmbeddedStructVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmbeddedStructVariadicParam", []string{"objs"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmbeddedStructVariadicParamCalledWith fails the test unless EmbeddedStructVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmbeddedStructVariadicParamCalledWith(objs any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmbeddedStructVariadicParam(&ExampleMockEmbeddedStructVariadicParamExpectation{
+		matchers: ExampleMockEmbeddedStructVariadicParamArgs{}.matchers(objs),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmbeddedStructVariadicParam(exp *ExampleMockEmbeddedStructVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmbeddedStructVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmbeddedStructVariadicParam", []string{"objs"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// EmptyInterfaceParam is a stub for the Example.EmptyInterfaceParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmptyInterfaceParam(intf interface{}) {
+	atomic.AddInt32(&m.EmptyInterfaceParamCalled, 1)
+	if exp := m.recordEmptyInterfaceParam(ExampleMockEmptyInterfaceParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.EmptyInterfaceParamStub == nil {
+		if m.T != nil {
+			m.T.Error("EmptyInterfaceParamStub is nil")
+		}
+		panic("EmptyInterfaceParam unimplemented")
+	}
+	m.EmptyInterfaceParamStub(intf)
+}
+
+// ExampleMockEmptyInterfaceParamArgs holds the arguments
+// of a call to ExampleMock.EmptyInterfaceParam.
+type ExampleMockEmptyInterfaceParamArgs struct {
+	Intf interface{}
+}
+
+func (args ExampleMockEmptyInterfaceParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmptyInterfaceParamArgs, rather than of the mock, so that the params of EmptyInterfaceParam
+// can't shadow the match package or the params' types.
+func (ExampleMockEmptyInterfaceParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[interface{}](vs[0])}
+}
+
+// EmptyInterfaceParamCalls returns the arguments of each call
+// made to EmptyInterfaceParam so far.
+func (m *ExampleMock) EmptyInterfaceParamCalls() []ExampleMockEmptyInterfaceParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmptyInterfaceParamArgs(nil), m.callsEmptyInterfaceParam...)
+}
+
+// ExampleMockEmptyInterfaceParamExpectation is an expected call
+// to ExampleMock.EmptyInterfaceParam, registered with OnEmptyInterfaceParam.
+type ExampleMockEmptyInterfaceParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockEmptyInterfaceParamExpectation) matches(args ExampleMockEmptyInterfaceParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnEmptyInterfaceParam registers an expected call to EmptyInterfaceParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmptyInterfaceParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmptyInterfaceParamStub is set.
+func (m *ExampleMock) OnEmptyInterfaceParam(intf any) *ExampleMockEmptyInterfaceParamExpectation {
+	return m.expectEmptyInterfaceParam(&ExampleMockEmptyInterfaceParamExpectation{
+		matchers: ExampleMockEmptyInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectEmptyInterfaceParam(exp *ExampleMockEmptyInterfaceParamExpectation) *ExampleMockEmptyInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmptyInterfaceParam = append(m.expectationsEmptyInterfaceParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmptyInterfaceParam(args ExampleMockEmptyInterfaceParamArgs) *ExampleMockEmptyInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmptyInterfaceParam = append(m.callsEmptyInterfaceParam, args)
+	for _, exp := range m.expectationsEmptyInterfaceParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmptyInterfaceParam) > 0 && m.EmptyInterfaceParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmptyInterfaceParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmptyInterfaceParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmptyInterfaceParamCalledWith fails the test unless EmptyInterfaceParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmptyInterfaceParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmptyInterfaceParam(&ExampleMockEmptyInterfaceParamExpectation{
+		matchers: ExampleMockEmptyInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmptyInterfaceParam(exp *ExampleMockEmptyInterfaceParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmptyInterfaceParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmptyInterfaceParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// EmptyInterfaceVariadicParam is a stub for the Example.EmptyInterfaceVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmptyInterfaceVariadicParam(intf ...interface{}) {
+	atomic.AddInt32(&m.EmptyInterfaceVariadicParamCalled, 1)
+	if exp := m.recordEmptyInterfaceVariadicParam(ExampleMockEmptyInterfaceVariadicParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.EmptyInterfaceVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("EmptyInterfaceVariadicParamStub is nil")
+		}
+		panic("EmptyInterfaceVariadicParam unimplemented")
+	}
+	m.EmptyInterfaceVariadicParamStub(intf...)
+}
+
+// ExampleMockEmptyInterfaceVariadicParamArgs holds the arguments
+// of a call to ExampleMock.EmptyInterfaceVariadicParam.
+type ExampleMockEmptyInterfaceVariadicParamArgs struct {
+	Intf []interface{}
+}
+
+func (args ExampleMockEmptyInterfaceVariadicParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmptyInterfaceVariadicParamArgs, rather than of the mock, so that the params of EmptyInterfaceVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockEmptyInterfaceVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]interface{}](vs[0])}
+}
+
+// EmptyInterfaceVariadicParamCalls returns the arguments of each call
+// made to EmptyInterfaceVariadicParam so far.
+func (m *ExampleMock) EmptyInterfaceVariadicParamCalls() []ExampleMockEmptyInterfaceVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmptyInterfaceVariadicParamArgs(nil), m.callsEmptyInterfaceVariadicParam...)
+}
+
+// ExampleMockEmptyInterfaceVariadicParamExpectation is an expected call
+// to ExampleMock.EmptyInterfaceVariadicParam, registered with OnEmptyInterfaceVariadicParam.
+type ExampleMockEmptyInterfaceVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockEmptyInterfaceVariadicParamExpectation) matches(args ExampleMockEmptyInterfaceVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnEmptyInterfaceVariadicParam registers an expected call to EmptyInterfaceVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmptyInterfaceVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmptyInterfaceVariadicParamStub is set.
+func (m *ExampleMock) OnEmptyInterfaceVariadicParam(intf any) *ExampleMockEmptyInterfaceVariadicParamExpectation {
+	return m.expectEmptyInterfaceVariadicParam(&ExampleMockEmptyInterfaceVariadicParamExpectation{
+		matchers: ExampleMockEmptyInterfaceVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectEmptyInterfaceVariadicParam(exp *ExampleMockEmptyInterfaceVariadicParamExpectation) *ExampleMockEmptyInterfaceVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmptyInterfaceVariadicParam = append(m.expectationsEmptyInterfaceVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmptyInterfaceVariadicParam(args ExampleMockEmptyInterfaceVariadicParamArgs) *ExampleMockEmptyInterfaceVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmptyInterfaceVariadicParam = append(m.callsEmptyInterfaceVariadicParam, args)
+	for _, exp := range m.expectationsEmptyInterfaceVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmptyInterfaceVariadicParam) > 0 && m.EmptyInterfaceVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmptyInterfaceVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmptyInterfaceVariadicParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmptyInterfaceVariadicParamCalledWith fails the test unless EmptyInterfaceVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmptyInterfaceVariadicParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmptyInterfaceVariadicParam(&ExampleMockEmptyInterfaceVariadicParamExpectation{
+		matchers: ExampleMockEmptyInterfaceVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmptyInterfaceVariadicParam(exp *ExampleMockEmptyInterfaceVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmptyInterfaceVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmptyInterfaceVariadicParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// InterfaceParam is a stub for the Example.InterfaceParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceParam(intf interface{ MyFunc(num int) error }) {
+	atomic.AddInt32(&m.InterfaceParamCalled, 1)
+	if exp := m.recordInterfaceParam(ExampleMockInterfaceParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.InterfaceParamStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceParamStub is nil")
+		}
+		panic("InterfaceParam unimplemented")
+	}
+	m.InterfaceParamStub(intf)
+}
+
+// ExampleMockInterfaceParamArgs holds the arguments
+// of a call to ExampleMock.InterfaceParam.
+type ExampleMockInterfaceParamArgs struct {
+	Intf interface{ MyFunc(num int) error }
+}
+
+func (args ExampleMockInterfaceParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceParamArgs, rather than of the mock, so that the params of InterfaceParam
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[interface{ MyFunc(num int) error }](vs[0])}
+}
+
+// InterfaceParamCalls returns the arguments of each call
+// made to InterfaceParam so far.
+func (m *ExampleMock) InterfaceParamCalls() []ExampleMockInterfaceParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceParamArgs(nil), m.callsInterfaceParam...)
+}
+
+// ExampleMockInterfaceParamExpectation is an expected call
+// to ExampleMock.InterfaceParam, registered with OnInterfaceParam.
+type ExampleMockInterfaceParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockInterfaceParamExpectation) matches(args ExampleMockInterfaceParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnInterfaceParam registers an expected call to InterfaceParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceParamStub is set.
+func (m *ExampleMock) OnInterfaceParam(intf any) *ExampleMockInterfaceParamExpectation {
+	return m.expectInterfaceParam(&ExampleMockInterfaceParamExpectation{
+		matchers: ExampleMockInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceParam(exp *ExampleMockInterfaceParamExpectation) *ExampleMockInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceParam = append(m.expectationsInterfaceParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceParam(args ExampleMockInterfaceParamArgs) *ExampleMockInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceParam = append(m.callsInterfaceParam, args)
+	for _, exp := range m.expectationsInterfaceParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceParam) > 0 && m.InterfaceParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceParamCalledWith fails the test unless InterfaceParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceParam(&ExampleMockInterfaceParamExpectation{
+		matchers: ExampleMockInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceParam(exp *ExampleMockInterfaceParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// InterfaceVariadicParam is a stub for the Example.InterfaceVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceVariadicParam(intf ...interface{ MyFunc(num int) error }) {
+	atomic.AddInt32(&m.InterfaceVariadicParamCalled, 1)
+	if exp := m.recordInterfaceVariadicParam(ExampleMockInterfaceVariadicParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.InterfaceVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceVariadicParamStub is nil")
+		}
+		panic("InterfaceVariadicParam unimplemented")
+	}
+	m.InterfaceVariadicParamStub(intf...)
+}
+
+// ExampleMockInterfaceVariadicParamArgs holds the arguments
+// of a call to ExampleMock.InterfaceVariadicParam.
+type ExampleMockInterfaceVariadicParamArgs struct {
+	Intf []interface{ MyFunc(num int) error }
+}
+
+func (args ExampleMockInterfaceVariadicParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceVariadicParamArgs, rather than of the mock, so that the params of InterfaceVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]interface{ MyFunc(num int) error }](vs[0])}
+}
+
+// InterfaceVariadicParamCalls returns the arguments of each call
+// made to InterfaceVariadicParam so far.
+func (m *ExampleMock) InterfaceVariadicParamCalls() []ExampleMockInterfaceVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceVariadicParamArgs(nil), m.callsInterfaceVariadicParam...)
+}
+
+// ExampleMockInterfaceVariadicParamExpectation is an expected call
+// to ExampleMock.InterfaceVariadicParam, registered with OnInterfaceVariadicParam.
+type ExampleMockInterfaceVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockInterfaceVariadicParamExpectation) matches(args ExampleMockInterfaceVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnInterfaceVariadicParam registers an expected call to InterfaceVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceVariadicParamStub is set.
+func (m *ExampleMock) OnInterfaceVariadicParam(intf any) *ExampleMockInterfaceVariadicParamExpectation {
+	return m.expectInterfaceVariadicParam(&ExampleMockInterfaceVariadicParamExpectation{
+		matchers: ExampleMockInterfaceVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceVariadicParam(exp *ExampleMockInterfaceVariadicParamExpectation) *ExampleMockInterfaceVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceVariadicParam = append(m.expectationsInterfaceVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceVariadicParam(args ExampleMockInterfaceVariadicParamArgs) *ExampleMockInterfaceVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceVariadicParam = append(m.callsInterfaceVariadicParam, args)
+	for _, exp := range m.expectationsInterfaceVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceVariadicParam) > 0 && m.InterfaceVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceVariadicParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceVariadicParamCalledWith fails the test unless InterfaceVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceVariadicParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceVariadicParam(&ExampleMockInterfaceVariadicParamExpectation{
+		matchers: ExampleMockInterfaceVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceVariadicParam(exp *ExampleMockInterfaceVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceVariadicParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// InterfaceVariadicFuncParam is a stub for the Example.InterfaceVariadicFuncParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceVariadicFuncParam(intf interface{ MyFunc(nums ...int) error }) {
+	atomic.AddInt32(&m.InterfaceVariadicFuncParamCalled, 1)
+	if exp := m.recordInterfaceVariadicFuncParam(ExampleMockInterfaceVariadicFuncParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.InterfaceVariadicFuncParamStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceVariadicFuncParamStub is nil")
+		}
+		panic("InterfaceVariadicFuncParam unimplemented")
+	}
+	m.InterfaceVariadicFuncParamStub(intf)
+}
+
+// ExampleMockInterfaceVariadicFuncParamArgs holds the arguments
+// of a call to ExampleMock.InterfaceVariadicFuncParam.
+type ExampleMockInterfaceVariadicFuncParamArgs struct {
+	Intf interface{ MyFunc(nums ...int) error }
+}
+
+func (args ExampleMockInterfaceVariadicFuncParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceVariadicFuncParamArgs, rather than of the mock, so that the params of InterfaceVariadicFuncParam
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceVariadicFuncParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[interface{ MyFunc(nums ...int) error }](vs[0])}
+}
+
+// InterfaceVariadicFuncParamCalls returns the arguments of each call
+// made to InterfaceVariadicFuncParam so far.
+func (m *ExampleMock) InterfaceVariadicFuncParamCalls() []ExampleMockInterfaceVariadicFuncParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceVariadicFuncParamArgs(nil), m.callsInterfaceVariadicFuncParam...)
+}
+
+// ExampleMockInterfaceVariadicFuncParamExpectation is an expected call
+// to ExampleMock.InterfaceVariadicFuncParam, registered with OnInterfaceVariadicFuncParam.
+type ExampleMockInterfaceVariadicFuncParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockInterfaceVariadicFuncParamExpectation) matches(args ExampleMockInterfaceVariadicFuncParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnInterfaceVariadicFuncParam registers an expected call to InterfaceVariadicFuncParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceVariadicFuncParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceVariadicFuncParamStub is set.
+func (m *ExampleMock) OnInterfaceVariadicFuncParam(intf any) *ExampleMockInterfaceVariadicFuncParamExpectation {
+	return m.expectInterfaceVariadicFuncParam(&ExampleMockInterfaceVariadicFuncParamExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceVariadicFuncParam(exp *ExampleMockInterfaceVariadicFuncParamExpectation) *ExampleMockInterfaceVariadicFuncParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceVariadicFuncParam = append(m.expectationsInterfaceVariadicFuncParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceVariadicFuncParam(args ExampleMockInterfaceVariadicFuncParamArgs) *ExampleMockInterfaceVariadicFuncParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceVariadicFuncParam = append(m.callsInterfaceVariadicFuncParam, args)
+	for _, exp := range m.expectationsInterfaceVariadicFuncParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceVariadicFuncParam) > 0 && m.InterfaceVariadicFuncParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceVariadicFuncParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceVariadicFuncParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceVariadicFuncParamCalledWith fails the test unless InterfaceVariadicFuncParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceVariadicFuncParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceVariadicFuncParam(&ExampleMockInterfaceVariadicFuncParamExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceVariadicFuncParam(exp *ExampleMockInterfaceVariadicFuncParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceVariadicFuncParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceVariadicFuncParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// InterfaceVariadicFuncVariadicParam is a stub for the Example.InterfaceVariadicFuncVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceVariadicFuncVariadicParam(intf ...interface{ MyFunc(nums ...int) error }) {
+	atomic.AddInt32(&m.InterfaceVariadicFuncVariadicParamCalled, 1)
+	if exp := m.recordInterfaceVariadicFuncVariadicParam(ExampleMockInterfaceVariadicFuncVariadicParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.InterfaceVariadicFuncVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceVariadicFuncVariadicParamStub is nil")
+		}
+		panic("InterfaceVariadicFuncVariadicParam unimplemented")
+	}
+	m.InterfaceVariadicFuncVariadicParamStub(intf...)
+}
+
+// ExampleMockInterfaceVariadicFuncVariadicParamArgs holds the arguments
+// of a call to ExampleMock.InterfaceVariadicFuncVariadicParam.
+type ExampleMockInterfaceVariadicFuncVariadicParamArgs struct {
+	Intf []interface{ MyFunc(nums ...int) error }
+}
+
+func (args ExampleMockInterfaceVariadicFuncVariadicParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceVariadicFuncVariadicParamArgs, rather than of the mock, so that the params of InterfaceVariadicFuncVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceVariadicFuncVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]interface{ MyFunc(nums ...int) error }](vs[0])}
+}
+
+// InterfaceVariadicFuncVariadicParamCalls returns the arguments of each call
+// made to InterfaceVariadicFuncVariadicParam so far.
+func (m *ExampleMock) InterfaceVariadicFuncVariadicParamCalls() []ExampleMockInterfaceVariadicFuncVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceVariadicFuncVariadicParamArgs(nil), m.callsInterfaceVariadicFuncVariadicParam...)
+}
+
+// ExampleMockInterfaceVariadicFuncVariadicParamExpectation is an expected call
+// to ExampleMock.InterfaceVariadicFuncVariadicParam, registered with OnInterfaceVariadicFuncVariadicParam.
+type ExampleMockInterfaceVariadicFuncVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockInterfaceVariadicFuncVariadicParamExpectation) matches(args ExampleMockInterfaceVariadicFuncVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnInterfaceVariadicFuncVariadicParam registers an expected call to InterfaceVariadicFuncVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceVariadicFuncVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceVariadicFuncVariadicParamStub is set.
+func (m *ExampleMock) OnInterfaceVariadicFuncVariadicParam(intf any) *ExampleMockInterfaceVariadicFuncVariadicParamExpectation {
+	return m.expectInterfaceVariadicFuncVariadicParam(&ExampleMockInterfaceVariadicFuncVariadicParamExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceVariadicFuncVariadicParam(exp *ExampleMockInterfaceVariadicFuncVariadicParamExpectation) *ExampleMockInterfaceVariadicFuncVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceVariadicFuncVariadicParam = append(m.expectationsInterfaceVariadicFuncVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceVariadicFuncVariadicParam(args ExampleMockInterfaceVariadicFuncVariadicParamArgs) *ExampleMockInterfaceVariadicFuncVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceVariadicFuncVariadicParam = append(m.callsInterfaceVariadicFuncVariadicParam, args)
+	for _, exp := range m.expectationsInterfaceVariadicFuncVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceVariadicFuncVariadicParam) > 0 && m.InterfaceVariadicFuncVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceVariadicFuncVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceVariadicFuncVariadicParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceVariadicFuncVariadicParamCalledWith fails the test unless InterfaceVariadicFuncVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceVariadicFuncVariadicParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceVariadicFuncVariadicParam(&ExampleMockInterfaceVariadicFuncVariadicParamExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncVariadicParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceVariadicFuncVariadicParam(exp *ExampleMockInterfaceVariadicFuncVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceVariadicFuncVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceVariadicFuncVariadicParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// EmbeddedInterfaceParam is a stub for the Example.EmbeddedInterfaceParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmbeddedInterfaceParam(intf interface{ fmt.Stringer }) {
+	atomic.AddInt32(&m.EmbeddedInterfaceParamCalled, 1)
+	if exp := m.recordEmbeddedInterfaceParam(ExampleMockEmbeddedInterfaceParamArgs{Intf: intf}); exp != nil {
+		return
+	}
+	if m.EmbeddedInterfaceParamStub == nil {
+		if m.T != nil {
+			m.T.Error("EmbeddedInterfaceParamStub is nil")
+		}
+		panic("EmbeddedInterfaceParam unimplemented")
+	}
+	m.EmbeddedInterfaceParamStub(intf)
+}
+
+// ExampleMockEmbeddedInterfaceParamArgs holds the arguments
+// of a call to ExampleMock.EmbeddedInterfaceParam.
+type ExampleMockEmbeddedInterfaceParamArgs struct {
+	Intf interface{ fmt.Stringer }
+}
+
+func (args ExampleMockEmbeddedInterfaceParamArgs) call() match.Call {
+	return match.Call{args.Intf}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmbeddedInterfaceParamArgs, rather than of the mock, so that the params of EmbeddedInterfaceParam
+// can't shadow the match package or the params' types.
+func (ExampleMockEmbeddedInterfaceParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[interface{ fmt.Stringer }](vs[0])}
+}
+
+// EmbeddedInterfaceParamCalls returns the arguments of each call
+// made to EmbeddedInterfaceParam so far.
+func (m *ExampleMock) EmbeddedInterfaceParamCalls() []ExampleMockEmbeddedInterfaceParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmbeddedInterfaceParamArgs(nil), m.callsEmbeddedInterfaceParam...)
+}
+
+// ExampleMockEmbeddedInterfaceParamExpectation is an expected call
+// to ExampleMock.EmbeddedInterfaceParam, registered with OnEmbeddedInterfaceParam.
+type ExampleMockEmbeddedInterfaceParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockEmbeddedInterfaceParamExpectation) matches(args ExampleMockEmbeddedInterfaceParamArgs) bool {
+	return exp.matchers[0].Matches(args.Intf)
+}
+
+// OnEmbeddedInterfaceParam registers an expected call to EmbeddedInterfaceParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmbeddedInterfaceParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmbeddedInterfaceParamStub is set.
+func (m *ExampleMock) OnEmbeddedInterfaceParam(intf any) *ExampleMockEmbeddedInterfaceParamExpectation {
+	return m.expectEmbeddedInterfaceParam(&ExampleMockEmbeddedInterfaceParamExpectation{
+		matchers: ExampleMockEmbeddedInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) expectEmbeddedInterfaceParam(exp *ExampleMockEmbeddedInterfaceParamExpectation) *ExampleMockEmbeddedInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmbeddedInterfaceParam = append(m.expectationsEmbeddedInterfaceParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmbeddedInterfaceParam(args ExampleMockEmbeddedInterfaceParamArgs) *ExampleMockEmbeddedInterfaceParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmbeddedInterfaceParam = append(m.callsEmbeddedInterfaceParam, args)
+	for _, exp := range m.expectationsEmbeddedInterfaceParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmbeddedInterfaceParam) > 0 && m.EmbeddedInterfaceParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmbeddedInterfaceParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmbeddedInterfaceParam", []string{"intf"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmbeddedInterfaceParamCalledWith fails the test unless EmbeddedInterfaceParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmbeddedInterfaceParamCalledWith(intf any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmbeddedInterfaceParam(&ExampleMockEmbeddedInterfaceParamExpectation{
+		matchers: ExampleMockEmbeddedInterfaceParamArgs{}.matchers(intf),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmbeddedInterfaceParam(exp *ExampleMockEmbeddedInterfaceParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmbeddedInterfaceParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmbeddedInterfaceParam", []string{"intf"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ChanParam is a stub for the Example.ChanParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) ChanParam(ch chan int) {
+	atomic.AddInt32(&m.ChanParamCalled, 1)
+	if exp := m.recordChanParam(ExampleMockChanParamArgs{Ch: ch}); exp != nil {
+		return
+	}
+	if m.ChanParamStub == nil {
+		if m.T != nil {
+			m.T.Error("ChanParamStub is nil")
+		}
+		panic("ChanParam unimplemented")
+	}
+	m.ChanParamStub(ch)
+}
+
+// ExampleMockChanParamArgs holds the arguments
+// of a call to ExampleMock.ChanParam.
+type ExampleMockChanParamArgs struct {
+	Ch chan int
+}
+
+func (args ExampleMockChanParamArgs) call() match.Call {
+	return match.Call{args.Ch}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockChanParamArgs, rather than of the mock, so that the params of ChanParam
+// can't shadow the match package or the params' types.
+func (ExampleMockChanParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[chan int](vs[0])}
+}
+
+// ChanParamCalls returns the arguments of each call
+// made to ChanParam so far.
+func (m *ExampleMock) ChanParamCalls() []ExampleMockChanParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockChanParamArgs(nil), m.callsChanParam...)
+}
+
+// ExampleMockChanParamExpectation is an expected call
+// to ExampleMock.ChanParam, registered with OnChanParam.
+type ExampleMockChanParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockChanParamExpectation) matches(args ExampleMockChanParamArgs) bool {
+	return exp.matchers[0].Matches(args.Ch)
+}
+
+// OnChanParam registers an expected call to ChanParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ChanParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ChanParamStub is set.
+func (m *ExampleMock) OnChanParam(ch any) *ExampleMockChanParamExpectation {
+	return m.expectChanParam(&ExampleMockChanParamExpectation{
+		matchers: ExampleMockChanParamArgs{}.matchers(ch),
+	})
+}
+
+func (m *ExampleMock) expectChanParam(exp *ExampleMockChanParamExpectation) *ExampleMockChanParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsChanParam = append(m.expectationsChanParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordChanParam(args ExampleMockChanParamArgs) *ExampleMockChanParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsChanParam = append(m.callsChanParam, args)
+	for _, exp := range m.expectationsChanParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsChanParam) > 0 && m.ChanParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsChanParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("ChanParam", []string{"ch"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertChanParamCalledWith fails the test unless ChanParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertChanParamCalledWith(ch any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithChanParam(&ExampleMockChanParamExpectation{
+		matchers: ExampleMockChanParamArgs{}.matchers(ch),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithChanParam(exp *ExampleMockChanParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ChanParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("ChanParam", []string{"ch"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// DirectionalChanParams is a stub for the Example.DirectionalChanParams
+// method that records the number of times it has been called.
+func (m *ExampleMock) DirectionalChanParams(recv <-chan int, send chan<- int) {
+	atomic.AddInt32(&m.DirectionalChanParamsCalled, 1)
+	if exp := m.recordDirectionalChanParams(ExampleMockDirectionalChanParamsArgs{Recv: recv, Send: send}); exp != nil {
+		return
+	}
+	if m.DirectionalChanParamsStub == nil {
+		if m.T != nil {
+			m.T.Error("DirectionalChanParamsStub is nil")
+		}
+		panic("DirectionalChanParams unimplemented")
+	}
+	m.DirectionalChanParamsStub(recv, send)
+}
+
+// ExampleMockDirectionalChanParamsArgs holds the arguments
+// of a call to ExampleMock.DirectionalChanParams.
+type ExampleMockDirectionalChanParamsArgs struct {
+	Recv <-chan int
+	Send chan<- int
+}
+
+func (args ExampleMockDirectionalChanParamsArgs) call() match.Call {
+	return match.Call{args.Recv, args.Send}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockDirectionalChanParamsArgs, rather than of the mock, so that the params of DirectionalChanParams
+// can't shadow the match package or the params' types.
+func (ExampleMockDirectionalChanParamsArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[<-chan int](vs[0]), match.OfType[chan<- int](vs[1])}
+}
+
+// DirectionalChanParamsCalls returns the arguments of each call
+// made to DirectionalChanParams so far.
+func (m *ExampleMock) DirectionalChanParamsCalls() []ExampleMockDirectionalChanParamsArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockDirectionalChanParamsArgs(nil), m.callsDirectionalChanParams...)
+}
+
+// ExampleMockDirectionalChanParamsExpectation is an expected call
+// to ExampleMock.DirectionalChanParams, registered with OnDirectionalChanParams.
+type ExampleMockDirectionalChanParamsExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockDirectionalChanParamsExpectation) matches(args ExampleMockDirectionalChanParamsArgs) bool {
+	return exp.matchers[0].Matches(args.Recv) &&
+		exp.matchers[1].Matches(args.Send)
+}
+
+// OnDirectionalChanParams registers an expected call to DirectionalChanParams, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling DirectionalChanParamsStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless DirectionalChanParamsStub is set.
+func (m *ExampleMock) OnDirectionalChanParams(recv, send any) *ExampleMockDirectionalChanParamsExpectation {
+	return m.expectDirectionalChanParams(&ExampleMockDirectionalChanParamsExpectation{
+		matchers: ExampleMockDirectionalChanParamsArgs{}.matchers(recv, send),
+	})
+}
+
+func (m *ExampleMock) expectDirectionalChanParams(exp *ExampleMockDirectionalChanParamsExpectation) *ExampleMockDirectionalChanParamsExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsDirectionalChanParams = append(m.expectationsDirectionalChanParams, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordDirectionalChanParams(args ExampleMockDirectionalChanParamsArgs) *ExampleMockDirectionalChanParamsExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsDirectionalChanParams = append(m.callsDirectionalChanParams, args)
+	for _, exp := range m.expectationsDirectionalChanParams {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsDirectionalChanParams) > 0 && m.DirectionalChanParamsStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsDirectionalChanParams {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("DirectionalChanParams", []string{"recv", "send"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertDirectionalChanParamsCalledWith fails the test unless DirectionalChanParams has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertDirectionalChanParamsCalledWith(recv, send any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithDirectionalChanParams(&ExampleMockDirectionalChanParamsExpectation{
+		matchers: ExampleMockDirectionalChanParamsArgs{}.matchers(recv, send),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithDirectionalChanParams(exp *ExampleMockDirectionalChanParamsExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.DirectionalChanParamsCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("DirectionalChanParams", []string{"recv", "send"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ChanVariadicParam is a stub for the Example.ChanVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) ChanVariadicParam(chs ...chan int) {
+	atomic.AddInt32(&m.ChanVariadicParamCalled, 1)
+	if exp := m.recordChanVariadicParam(ExampleMockChanVariadicParamArgs{Chs: chs}); exp != nil {
+		return
+	}
+	if m.ChanVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("ChanVariadicParamStub is nil")
+		}
+		panic("ChanVariadicParam unimplemented")
+	}
+	m.ChanVariadicParamStub(chs...)
+}
+
+// ExampleMockChanVariadicParamArgs holds the arguments
+// of a call to ExampleMock.ChanVariadicParam.
+type ExampleMockChanVariadicParamArgs struct {
+	Chs []chan int
+}
+
+func (args ExampleMockChanVariadicParamArgs) call() match.Call {
+	return match.Call{args.Chs}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockChanVariadicParamArgs, rather than of the mock, so that the params of ChanVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockChanVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]chan int](vs[0])}
+}
+
+// ChanVariadicParamCalls returns the arguments of each call
+// made to ChanVariadicParam so far.
+func (m *ExampleMock) ChanVariadicParamCalls() []ExampleMockChanVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockChanVariadicParamArgs(nil), m.callsChanVariadicParam...)
+}
+
+// ExampleMockChanVariadicParamExpectation is an expected call
+// to ExampleMock.ChanVariadicParam, registered with OnChanVariadicParam.
+type ExampleMockChanVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockChanVariadicParamExpectation) matches(args ExampleMockChanVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Chs)
+}
+
+// OnChanVariadicParam registers an expected call to ChanVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ChanVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ChanVariadicParamStub is set.
+func (m *ExampleMock) OnChanVariadicParam(chs any) *ExampleMockChanVariadicParamExpectation {
+	return m.expectChanVariadicParam(&ExampleMockChanVariadicParamExpectation{
+		matchers: ExampleMockChanVariadicParamArgs{}.matchers(chs),
+	})
+}
+
+func (m *ExampleMock) expectChanVariadicParam(exp *ExampleMockChanVariadicParamExpectation) *ExampleMockChanVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsChanVariadicParam = append(m.expectationsChanVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordChanVariadicParam(args ExampleMockChanVariadicParamArgs) *ExampleMockChanVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsChanVariadicParam = append(m.callsChanVariadicParam, args)
+	for _, exp := range m.expectationsChanVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsChanVariadicParam) > 0 && m.ChanVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsChanVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("ChanVariadicParam", []string{"chs"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertChanVariadicParamCalledWith fails the test unless ChanVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertChanVariadicParamCalledWith(chs any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithChanVariadicParam(&ExampleMockChanVariadicParamExpectation{
+		matchers: ExampleMockChanVariadicParamArgs{}.matchers(chs),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithChanVariadicParam(exp *ExampleMockChanVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ChanVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("ChanVariadicParam", []string{"chs"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// MapParam is a stub for the Example.MapParam
+// method that records the number of times it has been called.
+func (m_ *ExampleMock) MapParam(m map[string]int) {
+	atomic.AddInt32(&m_.MapParamCalled, 1)
+	if exp := m_.recordMapParam(ExampleMockMapParamArgs{M: m}); exp != nil {
+		return
+	}
+	if m_.MapParamStub == nil {
+		if m_.T != nil {
+			m_.T.Error("MapParamStub is nil")
+		}
+		panic("MapParam unimplemented")
+	}
+	m_.MapParamStub(m)
+}
+
+// ExampleMockMapParamArgs holds the arguments
+// of a call to ExampleMock.MapParam.
+type ExampleMockMapParamArgs struct {
+	M map[string]int
+}
+
+func (args ExampleMockMapParamArgs) call() match.Call {
+	return match.Call{args.M}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockMapParamArgs, rather than of the mock, so that the params of MapParam
+// can't shadow the match package or the params' types.
+func (ExampleMockMapParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[map[string]int](vs[0])}
+}
+
+// MapParamCalls returns the arguments of each call
+// made to MapParam so far.
+func (m *ExampleMock) MapParamCalls() []ExampleMockMapParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockMapParamArgs(nil), m.callsMapParam...)
+}
+
+// ExampleMockMapParamExpectation is an expected call
+// to ExampleMock.MapParam, registered with OnMapParam.
+type ExampleMockMapParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockMapParamExpectation) matches(args ExampleMockMapParamArgs) bool {
+	return exp.matchers[0].Matches(args.M)
+}
+
+// OnMapParam registers an expected call to MapParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling MapParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless MapParamStub is set.
+func (m_ *ExampleMock) OnMapParam(m any) *ExampleMockMapParamExpectation {
+	return m_.expectMapParam(&ExampleMockMapParamExpectation{
+		matchers: ExampleMockMapParamArgs{}.matchers(m),
+	})
+}
+
+func (m *ExampleMock) expectMapParam(exp *ExampleMockMapParamExpectation) *ExampleMockMapParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsMapParam = append(m.expectationsMapParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordMapParam(args ExampleMockMapParamArgs) *ExampleMockMapParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsMapParam = append(m.callsMapParam, args)
+	for _, exp := range m.expectationsMapParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsMapParam) > 0 && m.MapParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsMapParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("MapParam", []string{"m"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertMapParamCalledWith fails the test unless MapParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m_ *ExampleMock) AssertMapParamCalledWith(m any) bool {
+	if m_.T != nil {
+		m_.T.Helper()
+	}
+	return m_.assertCalledWithMapParam(&ExampleMockMapParamExpectation{
+		matchers: ExampleMockMapParamArgs{}.matchers(m),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithMapParam(exp *ExampleMockMapParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.MapParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("MapParam", []string{"m"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// MapVariadicParam is a stub for the Example.MapVariadicParam
+// method that records the number of times it has been called.
+func (m *ExampleMock) MapVariadicParam(ms ...map[string]int) {
+	atomic.AddInt32(&m.MapVariadicParamCalled, 1)
+	if exp := m.recordMapVariadicParam(ExampleMockMapVariadicParamArgs{Ms: ms}); exp != nil {
+		return
+	}
+	if m.MapVariadicParamStub == nil {
+		if m.T != nil {
+			m.T.Error("MapVariadicParamStub is nil")
+		}
+		panic("MapVariadicParam unimplemented")
+	}
+	m.MapVariadicParamStub(ms...)
+}
+
+// ExampleMockMapVariadicParamArgs holds the arguments
+// of a call to ExampleMock.MapVariadicParam.
+type ExampleMockMapVariadicParamArgs struct {
+	Ms []map[string]int
+}
+
+func (args ExampleMockMapVariadicParamArgs) call() match.Call {
+	return match.Call{args.Ms}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockMapVariadicParamArgs, rather than of the mock, so that the params of MapVariadicParam
+// can't shadow the match package or the params' types.
+func (ExampleMockMapVariadicParamArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[[]map[string]int](vs[0])}
+}
+
+// MapVariadicParamCalls returns the arguments of each call
+// made to MapVariadicParam so far.
+func (m *ExampleMock) MapVariadicParamCalls() []ExampleMockMapVariadicParamArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockMapVariadicParamArgs(nil), m.callsMapVariadicParam...)
+}
+
+// ExampleMockMapVariadicParamExpectation is an expected call
+// to ExampleMock.MapVariadicParam, registered with OnMapVariadicParam.
+type ExampleMockMapVariadicParamExpectation struct {
+	matchers []match.Matcher
+	calls    int
+}
+
+func (exp *ExampleMockMapVariadicParamExpectation) matches(args ExampleMockMapVariadicParamArgs) bool {
+	return exp.matchers[0].Matches(args.Ms)
+}
+
+// OnMapVariadicParam registers an expected call to MapVariadicParam, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling MapVariadicParamStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless MapVariadicParamStub is set.
+func (m *ExampleMock) OnMapVariadicParam(ms any) *ExampleMockMapVariadicParamExpectation {
+	return m.expectMapVariadicParam(&ExampleMockMapVariadicParamExpectation{
+		matchers: ExampleMockMapVariadicParamArgs{}.matchers(ms),
+	})
+}
+
+func (m *ExampleMock) expectMapVariadicParam(exp *ExampleMockMapVariadicParamExpectation) *ExampleMockMapVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsMapVariadicParam = append(m.expectationsMapVariadicParam, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordMapVariadicParam(args ExampleMockMapVariadicParamArgs) *ExampleMockMapVariadicParamExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsMapVariadicParam = append(m.callsMapVariadicParam, args)
+	for _, exp := range m.expectationsMapVariadicParam {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsMapVariadicParam) > 0 && m.MapVariadicParamStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsMapVariadicParam {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("MapVariadicParam", []string{"ms"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertMapVariadicParamCalledWith fails the test unless MapVariadicParam has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertMapVariadicParamCalledWith(ms any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithMapVariadicParam(&ExampleMockMapVariadicParamExpectation{
+		matchers: ExampleMockMapVariadicParamArgs{}.matchers(ms),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithMapVariadicParam(exp *ExampleMockMapVariadicParamExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.MapVariadicParamCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("MapVariadicParam", []string{"ms"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// UnnamedReturn is a stub for the Example.UnnamedReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) UnnamedReturn() error {
+	atomic.AddInt32(&m.UnnamedReturnCalled, 1)
+	if exp := m.recordUnnamedReturn(ExampleMockUnnamedReturnArgs{}); exp != nil {
+		return exp.results.Result1
+	}
+	if m.UnnamedReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("UnnamedReturnStub is nil")
+		}
+		panic("UnnamedReturn unimplemented")
+	}
+	return m.UnnamedReturnStub()
+}
+
+// ExampleMockUnnamedReturnArgs holds the arguments
+// of a call to ExampleMock.UnnamedReturn.
+type ExampleMockUnnamedReturnArgs struct {
+}
+
+func (args ExampleMockUnnamedReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockUnnamedReturnArgs, rather than of the mock, so that the params of UnnamedReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockUnnamedReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// UnnamedReturnCalls returns the arguments of each call
+// made to UnnamedReturn so far.
+func (m *ExampleMock) UnnamedReturnCalls() []ExampleMockUnnamedReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockUnnamedReturnArgs(nil), m.callsUnnamedReturn...)
+}
+
+// ExampleMockUnnamedReturnExpectation is an expected call
+// to ExampleMock.UnnamedReturn, registered with OnUnnamedReturn.
+type ExampleMockUnnamedReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockUnnamedReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockUnnamedReturnExpectation) Return(result1 error) {
+	exp.results = ExampleMockUnnamedReturnResults{Result1: result1}
+}
+
+func (exp *ExampleMockUnnamedReturnExpectation) matches(args ExampleMockUnnamedReturnArgs) bool {
+	return true
+}
+
+// OnUnnamedReturn registers an expected call to UnnamedReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling UnnamedReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless UnnamedReturnStub is set.
+func (m *ExampleMock) OnUnnamedReturn() *ExampleMockUnnamedReturnExpectation {
+	return m.expectUnnamedReturn(&ExampleMockUnnamedReturnExpectation{
+		matchers: ExampleMockUnnamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectUnnamedReturn(exp *ExampleMockUnnamedReturnExpectation) *ExampleMockUnnamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsUnnamedReturn = append(m.expectationsUnnamedReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordUnnamedReturn(args ExampleMockUnnamedReturnArgs) *ExampleMockUnnamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsUnnamedReturn = append(m.callsUnnamedReturn, args)
+	for _, exp := range m.expectationsUnnamedReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsUnnamedReturn) > 0 && m.UnnamedReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsUnnamedReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("UnnamedReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertUnnamedReturnCalledWith fails the test unless UnnamedReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertUnnamedReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithUnnamedReturn(&ExampleMockUnnamedReturnExpectation{
+		matchers: ExampleMockUnnamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithUnnamedReturn(exp *ExampleMockUnnamedReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.UnnamedReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("UnnamedReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockUnnamedReturnResults holds the results
+// of a call to ExampleMock.UnnamedReturn.
+type ExampleMockUnnamedReturnResults struct {
+	Result1 error
+}
+
+// UnnamedReturnReturnsSequence sets UnnamedReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) UnnamedReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockUnnamedReturnResults) {
+	var calls int32
+	m.UnnamedReturnStub = func() error {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("UnnamedReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
+// FailUnnamedReturnWith wraps UnnamedReturnStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *ExampleMock) FailUnnamedReturnWith(err error, rate float64) {
+	stub := m.UnnamedReturnStub
+	m.UnnamedReturnStub = func() (result1 error) {
+		if rand.Float64() < rate {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub()
+	}
+}
+
+// FailUnnamedReturnOnCall wraps UnnamedReturnStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *ExampleMock) FailUnnamedReturnOnCall(n int, err error) {
+	stub := m.UnnamedReturnStub
+	var calls int32
+	m.UnnamedReturnStub = func() (result1 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub()
+	}
+}
+
+// MultipleUnnamedReturn is a stub for the Example.MultipleUnnamedReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) MultipleUnnamedReturn() (int, error) {
+	atomic.AddInt32(&m.MultipleUnnamedReturnCalled, 1)
+	if exp := m.recordMultipleUnnamedReturn(ExampleMockMultipleUnnamedReturnArgs{}); exp != nil {
+		return exp.results.Result1, exp.results.Result2
+	}
+	if m.MultipleUnnamedReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("MultipleUnnamedReturnStub is nil")
+		}
+		panic("MultipleUnnamedReturn unimplemented")
+	}
+	return m.MultipleUnnamedReturnStub()
+}
+
+// ExampleMockMultipleUnnamedReturnArgs holds the arguments
+// of a call to ExampleMock.MultipleUnnamedReturn.
+type ExampleMockMultipleUnnamedReturnArgs struct {
+}
+
+func (args ExampleMockMultipleUnnamedReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockMultipleUnnamedReturnArgs, rather than of the mock, so that the params of MultipleUnnamedReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockMultipleUnnamedReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// MultipleUnnamedReturnCalls returns the arguments of each call
+// made to MultipleUnnamedReturn so far.
+func (m *ExampleMock) MultipleUnnamedReturnCalls() []ExampleMockMultipleUnnamedReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockMultipleUnnamedReturnArgs(nil), m.callsMultipleUnnamedReturn...)
+}
+
+// ExampleMockMultipleUnnamedReturnExpectation is an expected call
+// to ExampleMock.MultipleUnnamedReturn, registered with OnMultipleUnnamedReturn.
+type ExampleMockMultipleUnnamedReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockMultipleUnnamedReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockMultipleUnnamedReturnExpectation) Return(result1 int, result2 error) {
+	exp.results = ExampleMockMultipleUnnamedReturnResults{Result1: result1, Result2: result2}
+}
+
+func (exp *ExampleMockMultipleUnnamedReturnExpectation) matches(args ExampleMockMultipleUnnamedReturnArgs) bool {
+	return true
+}
+
+// OnMultipleUnnamedReturn registers an expected call to MultipleUnnamedReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling MultipleUnnamedReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless MultipleUnnamedReturnStub is set.
+func (m *ExampleMock) OnMultipleUnnamedReturn() *ExampleMockMultipleUnnamedReturnExpectation {
+	return m.expectMultipleUnnamedReturn(&ExampleMockMultipleUnnamedReturnExpectation{
+		matchers: ExampleMockMultipleUnnamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectMultipleUnnamedReturn(exp *ExampleMockMultipleUnnamedReturnExpectation) *ExampleMockMultipleUnnamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsMultipleUnnamedReturn = append(m.expectationsMultipleUnnamedReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordMultipleUnnamedReturn(args ExampleMockMultipleUnnamedReturnArgs) *ExampleMockMultipleUnnamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsMultipleUnnamedReturn = append(m.callsMultipleUnnamedReturn, args)
+	for _, exp := range m.expectationsMultipleUnnamedReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsMultipleUnnamedReturn) > 0 && m.MultipleUnnamedReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsMultipleUnnamedReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("MultipleUnnamedReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertMultipleUnnamedReturnCalledWith fails the test unless MultipleUnnamedReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertMultipleUnnamedReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithMultipleUnnamedReturn(&ExampleMockMultipleUnnamedReturnExpectation{
+		matchers: ExampleMockMultipleUnnamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithMultipleUnnamedReturn(exp *ExampleMockMultipleUnnamedReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.MultipleUnnamedReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("MultipleUnnamedReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockMultipleUnnamedReturnResults holds the results
+// of a call to ExampleMock.MultipleUnnamedReturn.
+type ExampleMockMultipleUnnamedReturnResults struct {
+	Result1 int
+	Result2 error
+}
+
+// MultipleUnnamedReturnReturnsSequence sets MultipleUnnamedReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) MultipleUnnamedReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockMultipleUnnamedReturnResults) {
+	var calls int32
+	m.MultipleUnnamedReturnStub = func() (int, error) {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("MultipleUnnamedReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1, results[i].Result2
+	}
+}
+
+// FailMultipleUnnamedReturnWith wraps MultipleUnnamedReturnStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *ExampleMock) FailMultipleUnnamedReturnWith(err error, rate float64) {
+	stub := m.MultipleUnnamedReturnStub
+	m.MultipleUnnamedReturnStub = func() (result1 int, result2 error) {
+		if rand.Float64() < rate {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub()
+	}
+}
+
+// FailMultipleUnnamedReturnOnCall wraps MultipleUnnamedReturnStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *ExampleMock) FailMultipleUnnamedReturnOnCall(n int, err error) {
+	stub := m.MultipleUnnamedReturnStub
+	var calls int32
+	m.MultipleUnnamedReturnStub = func() (result1 int, result2 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub()
+	}
+}
+
+// BlankReturn is a stub for the Example.BlankReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) BlankReturn() (_ error) {
+	atomic.AddInt32(&m.BlankReturnCalled, 1)
+	if exp := m.recordBlankReturn(ExampleMockBlankReturnArgs{}); exp != nil {
+		return exp.results.Result1
+	}
+	if m.BlankReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("BlankReturnStub is nil")
+		}
+		panic("BlankReturn unimplemented")
+	}
+	return m.BlankReturnStub()
+}
+
+// ExampleMockBlankReturnArgs holds the arguments
+// of a call to ExampleMock.BlankReturn.
+type ExampleMockBlankReturnArgs struct {
+}
+
+func (args ExampleMockBlankReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockBlankReturnArgs, rather than of the mock, so that the params of BlankReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockBlankReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// BlankReturnCalls returns the arguments of each call
+// made to BlankReturn so far.
+func (m *ExampleMock) BlankReturnCalls() []ExampleMockBlankReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockBlankReturnArgs(nil), m.callsBlankReturn...)
+}
+
+// ExampleMockBlankReturnExpectation is an expected call
+// to ExampleMock.BlankReturn, registered with OnBlankReturn.
+type ExampleMockBlankReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockBlankReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockBlankReturnExpectation) Return(result1 error) {
+	exp.results = ExampleMockBlankReturnResults{Result1: result1}
+}
+
+func (exp *ExampleMockBlankReturnExpectation) matches(args ExampleMockBlankReturnArgs) bool {
+	return true
+}
+
+// OnBlankReturn registers an expected call to BlankReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling BlankReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless BlankReturnStub is set.
+func (m *ExampleMock) OnBlankReturn() *ExampleMockBlankReturnExpectation {
+	return m.expectBlankReturn(&ExampleMockBlankReturnExpectation{
+		matchers: ExampleMockBlankReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectBlankReturn(exp *ExampleMockBlankReturnExpectation) *ExampleMockBlankReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsBlankReturn = append(m.expectationsBlankReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordBlankReturn(args ExampleMockBlankReturnArgs) *ExampleMockBlankReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsBlankReturn = append(m.callsBlankReturn, args)
+	for _, exp := range m.expectationsBlankReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsBlankReturn) > 0 && m.BlankReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsBlankReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("BlankReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertBlankReturnCalledWith fails the test unless BlankReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertBlankReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithBlankReturn(&ExampleMockBlankReturnExpectation{
+		matchers: ExampleMockBlankReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithBlankReturn(exp *ExampleMockBlankReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.BlankReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("BlankReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockBlankReturnResults holds the results
+// of a call to ExampleMock.BlankReturn.
+type ExampleMockBlankReturnResults struct {
+	Result1 error
+}
+
+// BlankReturnReturnsSequence sets BlankReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) BlankReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockBlankReturnResults) {
+	var calls int32
+	m.BlankReturnStub = func() error {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("BlankReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
+// FailBlankReturnWith wraps BlankReturnStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *ExampleMock) FailBlankReturnWith(err error, rate float64) {
+	stub := m.BlankReturnStub
+	m.BlankReturnStub = func() (result1 error) {
+		if rand.Float64() < rate {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub()
+	}
+}
+
+// FailBlankReturnOnCall wraps BlankReturnStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *ExampleMock) FailBlankReturnOnCall(n int, err error) {
+	stub := m.BlankReturnStub
+	var calls int32
+	m.BlankReturnStub = func() (result1 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub()
+	}
+}
+
+// NamedReturn is a stub for the Example.NamedReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) NamedReturn() (err error) {
+	atomic.AddInt32(&m.NamedReturnCalled, 1)
+	if exp := m.recordNamedReturn(ExampleMockNamedReturnArgs{}); exp != nil {
+		return exp.results.Err
+	}
+	if m.NamedReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("NamedReturnStub is nil")
+		}
+		panic("NamedReturn unimplemented")
+	}
+	return m.NamedReturnStub()
+}
+
+// ExampleMockNamedReturnArgs holds the arguments
+// of a call to ExampleMock.NamedReturn.
+type ExampleMockNamedReturnArgs struct {
+}
+
+func (args ExampleMockNamedReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockNamedReturnArgs, rather than of the mock, so that the params of NamedReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockNamedReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// NamedReturnCalls returns the arguments of each call
+// made to NamedReturn so far.
+func (m *ExampleMock) NamedReturnCalls() []ExampleMockNamedReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockNamedReturnArgs(nil), m.callsNamedReturn...)
+}
+
+// ExampleMockNamedReturnExpectation is an expected call
+// to ExampleMock.NamedReturn, registered with OnNamedReturn.
+type ExampleMockNamedReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockNamedReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockNamedReturnExpectation) Return(err error) {
+	exp.results = ExampleMockNamedReturnResults{Err: err}
+}
+
+func (exp *ExampleMockNamedReturnExpectation) matches(args ExampleMockNamedReturnArgs) bool {
+	return true
+}
+
+// OnNamedReturn registers an expected call to NamedReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling NamedReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless NamedReturnStub is set.
+func (m *ExampleMock) OnNamedReturn() *ExampleMockNamedReturnExpectation {
+	return m.expectNamedReturn(&ExampleMockNamedReturnExpectation{
+		matchers: ExampleMockNamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectNamedReturn(exp *ExampleMockNamedReturnExpectation) *ExampleMockNamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsNamedReturn = append(m.expectationsNamedReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordNamedReturn(args ExampleMockNamedReturnArgs) *ExampleMockNamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsNamedReturn = append(m.callsNamedReturn, args)
+	for _, exp := range m.expectationsNamedReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsNamedReturn) > 0 && m.NamedReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsNamedReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("NamedReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertNamedReturnCalledWith fails the test unless NamedReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertNamedReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithNamedReturn(&ExampleMockNamedReturnExpectation{
+		matchers: ExampleMockNamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithNamedReturn(exp *ExampleMockNamedReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.NamedReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("NamedReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockNamedReturnResults holds the results
+// of a call to ExampleMock.NamedReturn.
+type ExampleMockNamedReturnResults struct {
+	Err error
+}
+
+// NamedReturnReturnsSequence sets NamedReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) NamedReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockNamedReturnResults) {
+	var calls int32
+	m.NamedReturnStub = func() error {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("NamedReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Err
+	}
+}
+
+// FailNamedReturnWith wraps NamedReturnStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *ExampleMock) FailNamedReturnWith(err_ error, rate float64) {
+	stub := m.NamedReturnStub
+	m.NamedReturnStub = func() (err error) {
+		if rand.Float64() < rate {
+			err = err_
+			return err
+		}
+		if stub == nil {
+			return err
+		}
+		return stub()
+	}
+}
+
+// FailNamedReturnOnCall wraps NamedReturnStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *ExampleMock) FailNamedReturnOnCall(n int, err_ error) {
+	stub := m.NamedReturnStub
+	var calls int32
+	m.NamedReturnStub = func() (err error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			err = err_
+			return err
+		}
+		if stub == nil {
+			return err
+		}
+		return stub()
+	}
+}
+
+// SameTypeNamedReturn is a stub for the Example.SameTypeNamedReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) SameTypeNamedReturn() (err1 error, err2 error) {
+	atomic.AddInt32(&m.SameTypeNamedReturnCalled, 1)
+	if exp := m.recordSameTypeNamedReturn(ExampleMockSameTypeNamedReturnArgs{}); exp != nil {
+		return exp.results.Err1, exp.results.Err2
+	}
+	if m.SameTypeNamedReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("SameTypeNamedReturnStub is nil")
+		}
+		panic("SameTypeNamedReturn unimplemented")
+	}
+	return m.SameTypeNamedReturnStub()
+}
+
+// ExampleMockSameTypeNamedReturnArgs holds the arguments
+// of a call to ExampleMock.SameTypeNamedReturn.
+type ExampleMockSameTypeNamedReturnArgs struct {
+}
+
+func (args ExampleMockSameTypeNamedReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockSameTypeNamedReturnArgs, rather than of the mock, so that the params of SameTypeNamedReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockSameTypeNamedReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// SameTypeNamedReturnCalls returns the arguments of each call
+// made to SameTypeNamedReturn so far.
+func (m *ExampleMock) SameTypeNamedReturnCalls() []ExampleMockSameTypeNamedReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockSameTypeNamedReturnArgs(nil), m.callsSameTypeNamedReturn...)
+}
+
+// ExampleMockSameTypeNamedReturnExpectation is an expected call
+// to ExampleMock.SameTypeNamedReturn, registered with OnSameTypeNamedReturn.
+type ExampleMockSameTypeNamedReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockSameTypeNamedReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockSameTypeNamedReturnExpectation) Return(err1 error, err2 error) {
+	exp.results = ExampleMockSameTypeNamedReturnResults{Err1: err1, Err2: err2}
+}
+
+func (exp *ExampleMockSameTypeNamedReturnExpectation) matches(args ExampleMockSameTypeNamedReturnArgs) bool {
+	return true
+}
+
+// OnSameTypeNamedReturn registers an expected call to SameTypeNamedReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling SameTypeNamedReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless SameTypeNamedReturnStub is set.
+func (m *ExampleMock) OnSameTypeNamedReturn() *ExampleMockSameTypeNamedReturnExpectation {
+	return m.expectSameTypeNamedReturn(&ExampleMockSameTypeNamedReturnExpectation{
+		matchers: ExampleMockSameTypeNamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectSameTypeNamedReturn(exp *ExampleMockSameTypeNamedReturnExpectation) *ExampleMockSameTypeNamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsSameTypeNamedReturn = append(m.expectationsSameTypeNamedReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordSameTypeNamedReturn(args ExampleMockSameTypeNamedReturnArgs) *ExampleMockSameTypeNamedReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsSameTypeNamedReturn = append(m.callsSameTypeNamedReturn, args)
+	for _, exp := range m.expectationsSameTypeNamedReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsSameTypeNamedReturn) > 0 && m.SameTypeNamedReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsSameTypeNamedReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("SameTypeNamedReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertSameTypeNamedReturnCalledWith fails the test unless SameTypeNamedReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertSameTypeNamedReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithSameTypeNamedReturn(&ExampleMockSameTypeNamedReturnExpectation{
+		matchers: ExampleMockSameTypeNamedReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithSameTypeNamedReturn(exp *ExampleMockSameTypeNamedReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.SameTypeNamedReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("SameTypeNamedReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockSameTypeNamedReturnResults holds the results
+// of a call to ExampleMock.SameTypeNamedReturn.
+type ExampleMockSameTypeNamedReturnResults struct {
+	Err1 error
+	Err2 error
+}
+
+// SameTypeNamedReturnReturnsSequence sets SameTypeNamedReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) SameTypeNamedReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockSameTypeNamedReturnResults) {
+	var calls int32
+	m.SameTypeNamedReturnStub = func() (error, error) {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("SameTypeNamedReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Err1, results[i].Err2
+	}
+}
+
+// FailSameTypeNamedReturnWith wraps SameTypeNamedReturnStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *ExampleMock) FailSameTypeNamedReturnWith(err error, rate float64) {
+	stub := m.SameTypeNamedReturnStub
+	m.SameTypeNamedReturnStub = func() (err1 error, err2 error) {
+		if rand.Float64() < rate {
+			err2 = err
+			return err1, err2
+		}
+		if stub == nil {
+			return err1, err2
+		}
+		return stub()
+	}
+}
+
+// FailSameTypeNamedReturnOnCall wraps SameTypeNamedReturnStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *ExampleMock) FailSameTypeNamedReturnOnCall(n int, err error) {
+	stub := m.SameTypeNamedReturnStub
+	var calls int32
+	m.SameTypeNamedReturnStub = func() (err1 error, err2 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			err2 = err
+			return err1, err2
+		}
+		if stub == nil {
+			return err1, err2
+		}
+		return stub()
+	}
+}
+
+// RenamedImportReturn is a stub for the Example.RenamedImportReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) RenamedImportReturn() (tmpl renamed.Template) {
+	atomic.AddInt32(&m.RenamedImportReturnCalled, 1)
+	if exp := m.recordRenamedImportReturn(ExampleMockRenamedImportReturnArgs{}); exp != nil {
+		return exp.results.Tmpl
+	}
+	if m.RenamedImportReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("RenamedImportReturnStub is nil")
+		}
+		panic("RenamedImportReturn unimplemented")
+	}
+	return m.RenamedImportReturnStub()
+}
+
+// ExampleMockRenamedImportReturnArgs holds the arguments
+// of a call to ExampleMock.RenamedImportReturn.
+type ExampleMockRenamedImportReturnArgs struct {
+}
+
+func (args ExampleMockRenamedImportReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockRenamedImportReturnArgs, rather than of the mock, so that the params of RenamedImportReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockRenamedImportReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// RenamedImportReturnCalls returns the arguments of each call
+// made to RenamedImportReturn so far.
+func (m *ExampleMock) RenamedImportReturnCalls() []ExampleMockRenamedImportReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockRenamedImportReturnArgs(nil), m.callsRenamedImportReturn...)
+}
+
+// ExampleMockRenamedImportReturnExpectation is an expected call
+// to ExampleMock.RenamedImportReturn, registered with OnRenamedImportReturn.
+type ExampleMockRenamedImportReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockRenamedImportReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockRenamedImportReturnExpectation) Return(tmpl renamed.Template) {
+	exp.results = ExampleMockRenamedImportReturnResults{Tmpl: tmpl}
+}
+
+func (exp *ExampleMockRenamedImportReturnExpectation) matches(args ExampleMockRenamedImportReturnArgs) bool {
+	return true
+}
+
+// OnRenamedImportReturn registers an expected call to RenamedImportReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling RenamedImportReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless RenamedImportReturnStub is set.
+func (m *ExampleMock) OnRenamedImportReturn() *ExampleMockRenamedImportReturnExpectation {
+	return m.expectRenamedImportReturn(&ExampleMockRenamedImportReturnExpectation{
+		matchers: ExampleMockRenamedImportReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectRenamedImportReturn(exp *ExampleMockRenamedImportReturnExpectation) *ExampleMockRenamedImportReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsRenamedImportReturn = append(m.expectationsRenamedImportReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordRenamedImportReturn(args ExampleMockRenamedImportReturnArgs) *ExampleMockRenamedImportReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsRenamedImportReturn = append(m.callsRenamedImportReturn, args)
+	for _, exp := range m.expectationsRenamedImportReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsRenamedImportReturn) > 0 && m.RenamedImportReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsRenamedImportReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("RenamedImportReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertRenamedImportReturnCalledWith fails the test unless RenamedImportReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertRenamedImportReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithRenamedImportReturn(&ExampleMockRenamedImportReturnExpectation{
+		matchers: ExampleMockRenamedImportReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithRenamedImportReturn(exp *ExampleMockRenamedImportReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.RenamedImportReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("RenamedImportReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockRenamedImportReturnResults holds the results
+// of a call to ExampleMock.RenamedImportReturn.
+type ExampleMockRenamedImportReturnResults struct {
+	Tmpl renamed.Template
+}
+
+// RenamedImportReturnReturnsSequence sets RenamedImportReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) RenamedImportReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockRenamedImportReturnResults) {
+	var calls int32
+	m.RenamedImportReturnStub = func() renamed.Template {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("RenamedImportReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Tmpl
+	}
+}
+
+// DotImportReturn is a stub for the Example.DotImportReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) DotImportReturn() (file File) {
+	atomic.AddInt32(&m.DotImportReturnCalled, 1)
+	if exp := m.recordDotImportReturn(ExampleMockDotImportReturnArgs{}); exp != nil {
+		return exp.results.File
+	}
+	if m.DotImportReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("DotImportReturnStub is nil")
+		}
+		panic("DotImportReturn unimplemented")
+	}
+	return m.DotImportReturnStub()
+}
+
+// ExampleMockDotImportReturnArgs holds the arguments
+// of a call to ExampleMock.DotImportReturn.
+type ExampleMockDotImportReturnArgs struct {
+}
+
+func (args ExampleMockDotImportReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockDotImportReturnArgs, rather than of the mock, so that the params of DotImportReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockDotImportReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// DotImportReturnCalls returns the arguments of each call
+// made to DotImportReturn so far.
+func (m *ExampleMock) DotImportReturnCalls() []ExampleMockDotImportReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockDotImportReturnArgs(nil), m.callsDotImportReturn...)
+}
+
+// ExampleMockDotImportReturnExpectation is an expected call
+// to ExampleMock.DotImportReturn, registered with OnDotImportReturn.
+type ExampleMockDotImportReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockDotImportReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockDotImportReturnExpectation) Return(file File) {
+	exp.results = ExampleMockDotImportReturnResults{File: file}
+}
+
+func (exp *ExampleMockDotImportReturnExpectation) matches(args ExampleMockDotImportReturnArgs) bool {
+	return true
+}
+
+// OnDotImportReturn registers an expected call to DotImportReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling DotImportReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless DotImportReturnStub is set.
+func (m *ExampleMock) OnDotImportReturn() *ExampleMockDotImportReturnExpectation {
+	return m.expectDotImportReturn(&ExampleMockDotImportReturnExpectation{
+		matchers: ExampleMockDotImportReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectDotImportReturn(exp *ExampleMockDotImportReturnExpectation) *ExampleMockDotImportReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsDotImportReturn = append(m.expectationsDotImportReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordDotImportReturn(args ExampleMockDotImportReturnArgs) *ExampleMockDotImportReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsDotImportReturn = append(m.callsDotImportReturn, args)
+	for _, exp := range m.expectationsDotImportReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsDotImportReturn) > 0 && m.DotImportReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsDotImportReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("DotImportReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertDotImportReturnCalledWith fails the test unless DotImportReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertDotImportReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithDotImportReturn(&ExampleMockDotImportReturnExpectation{
+		matchers: ExampleMockDotImportReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithDotImportReturn(exp *ExampleMockDotImportReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.DotImportReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("DotImportReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockDotImportReturnResults holds the results
+// of a call to ExampleMock.DotImportReturn.
+type ExampleMockDotImportReturnResults struct {
+	File File
+}
+
+// DotImportReturnReturnsSequence sets DotImportReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) DotImportReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockDotImportReturnResults) {
+	var calls int32
+	m.DotImportReturnStub = func() File {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("DotImportReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].File
+	}
+}
+
+// SelfReferentialReturn is a stub for the Example.SelfReferentialReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) SelfReferentialReturn() (intf Example) {
+	atomic.AddInt32(&m.SelfReferentialReturnCalled, 1)
+	if exp := m.recordSelfReferentialReturn(ExampleMockSelfReferentialReturnArgs{}); exp != nil {
+		return exp.results.Intf
+	}
+	if m.SelfReferentialReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("SelfReferentialReturnStub is nil")
+		}
+		panic("SelfReferentialReturn unimplemented")
+	}
+	return m.SelfReferentialReturnStub()
+}
+
+// ExampleMockSelfReferentialReturnArgs holds the arguments
+// of a call to ExampleMock.SelfReferentialReturn.
+type ExampleMockSelfReferentialReturnArgs struct {
+}
+
+func (args ExampleMockSelfReferentialReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockSelfReferentialReturnArgs, rather than of the mock, so that the params of SelfReferentialReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockSelfReferentialReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// SelfReferentialReturnCalls returns the arguments of each call
+// made to SelfReferentialReturn so far.
+func (m *ExampleMock) SelfReferentialReturnCalls() []ExampleMockSelfReferentialReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockSelfReferentialReturnArgs(nil), m.callsSelfReferentialReturn...)
+}
+
+// ExampleMockSelfReferentialReturnExpectation is an expected call
+// to ExampleMock.SelfReferentialReturn, registered with OnSelfReferentialReturn.
+type ExampleMockSelfReferentialReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockSelfReferentialReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockSelfReferentialReturnExpectation) Return(intf Example) {
+	exp.results = ExampleMockSelfReferentialReturnResults{Intf: intf}
+}
+
+func (exp *ExampleMockSelfReferentialReturnExpectation) matches(args ExampleMockSelfReferentialReturnArgs) bool {
+	return true
+}
+
+// OnSelfReferentialReturn registers an expected call to SelfReferentialReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling SelfReferentialReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless SelfReferentialReturnStub is set.
+func (m *ExampleMock) OnSelfReferentialReturn() *ExampleMockSelfReferentialReturnExpectation {
+	return m.expectSelfReferentialReturn(&ExampleMockSelfReferentialReturnExpectation{
+		matchers: ExampleMockSelfReferentialReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectSelfReferentialReturn(exp *ExampleMockSelfReferentialReturnExpectation) *ExampleMockSelfReferentialReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsSelfReferentialReturn = append(m.expectationsSelfReferentialReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordSelfReferentialReturn(args ExampleMockSelfReferentialReturnArgs) *ExampleMockSelfReferentialReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsSelfReferentialReturn = append(m.callsSelfReferentialReturn, args)
+	for _, exp := range m.expectationsSelfReferentialReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsSelfReferentialReturn) > 0 && m.SelfReferentialReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsSelfReferentialReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("SelfReferentialReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertSelfReferentialReturnCalledWith fails the test unless SelfReferentialReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertSelfReferentialReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithSelfReferentialReturn(&ExampleMockSelfReferentialReturnExpectation{
+		matchers: ExampleMockSelfReferentialReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithSelfReferentialReturn(exp *ExampleMockSelfReferentialReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.SelfReferentialReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("SelfReferentialReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockSelfReferentialReturnResults holds the results
+// of a call to ExampleMock.SelfReferentialReturn.
+type ExampleMockSelfReferentialReturnResults struct {
+	Intf Example
+}
+
+// SelfReferentialReturnReturnsSequence sets SelfReferentialReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) SelfReferentialReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockSelfReferentialReturnResults) {
+	var calls int32
+	m.SelfReferentialReturnStub = func() Example {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("SelfReferentialReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Intf
+	}
+}
+
+// StructReturn is a stub for the Example.StructReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) StructReturn() (obj struct{ num int }) {
+	atomic.AddInt32(&m.StructReturnCalled, 1)
+	if exp := m.recordStructReturn(ExampleMockStructReturnArgs{}); exp != nil {
+		return exp.results.Obj
+	}
+	if m.StructReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("StructReturnStub is nil")
+		}
+		panic("StructReturn unimplemented")
+	}
+	return m.StructReturnStub()
+}
+
+// ExampleMockStructReturnArgs holds the arguments
+// of a call to ExampleMock.StructReturn.
+type ExampleMockStructReturnArgs struct {
+}
+
+func (args ExampleMockStructReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockStructReturnArgs, rather than of the mock, so that the params of StructReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockStructReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// StructReturnCalls returns the arguments of each call
+// made to StructReturn so far.
+func (m *ExampleMock) StructReturnCalls() []ExampleMockStructReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockStructReturnArgs(nil), m.callsStructReturn...)
+}
+
+// ExampleMockStructReturnExpectation is an expected call
+// to ExampleMock.StructReturn, registered with OnStructReturn.
+type ExampleMockStructReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockStructReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockStructReturnExpectation) Return(obj struct{ num int }) {
+	exp.results = ExampleMockStructReturnResults{Obj: obj}
+}
+
+func (exp *ExampleMockStructReturnExpectation) matches(args ExampleMockStructReturnArgs) bool {
+	return true
+}
+
+// OnStructReturn registers an expected call to StructReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling StructReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless StructReturnStub is set.
+func (m *ExampleMock) OnStructReturn() *ExampleMockStructReturnExpectation {
+	return m.expectStructReturn(&ExampleMockStructReturnExpectation{
+		matchers: ExampleMockStructReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectStructReturn(exp *ExampleMockStructReturnExpectation) *ExampleMockStructReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsStructReturn = append(m.expectationsStructReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordStructReturn(args ExampleMockStructReturnArgs) *ExampleMockStructReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsStructReturn = append(m.callsStructReturn, args)
+	for _, exp := range m.expectationsStructReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsStructReturn) > 0 && m.StructReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsStructReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("StructReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertStructReturnCalledWith fails the test unless StructReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertStructReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithStructReturn(&ExampleMockStructReturnExpectation{
+		matchers: ExampleMockStructReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithStructReturn(exp *ExampleMockStructReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.StructReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("StructReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockStructReturnResults holds the results
+// of a call to ExampleMock.StructReturn.
+type ExampleMockStructReturnResults struct {
+	Obj struct{ num int }
+}
+
+// StructReturnReturnsSequence sets StructReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) StructReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockStructReturnResults) {
+	var calls int32
+	m.StructReturnStub = func() struct{ num int } {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("StructReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Obj
+	}
+}
+
+// EmbeddedStructReturn is a stub for the Example.EmbeddedStructReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmbeddedStructReturn() (obj struct{ int }) {
+	atomic.AddInt32(&m.EmbeddedStructReturnCalled, 1)
+	if exp := m.recordEmbeddedStructReturn(ExampleMockEmbeddedStructReturnArgs{}); exp != nil {
+		return exp.results.Obj
+	}
+	if m.EmbeddedStructReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("EmbeddedStructReturnStub is nil")
+		}
+		panic("EmbeddedStructReturn unimplemented")
+	}
+	return m.EmbeddedStructReturnStub()
+}
+
+// ExampleMockEmbeddedStructReturnArgs holds the arguments
+// of a call to ExampleMock.EmbeddedStructReturn.
+type ExampleMockEmbeddedStructReturnArgs struct {
+}
+
+func (args ExampleMockEmbeddedStructReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmbeddedStructReturnArgs, rather than of the mock, so that the params of EmbeddedStructReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockEmbeddedStructReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// EmbeddedStructReturnCalls returns the arguments of each call
+// made to EmbeddedStructReturn so far.
+func (m *ExampleMock) EmbeddedStructReturnCalls() []ExampleMockEmbeddedStructReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmbeddedStructReturnArgs(nil), m.callsEmbeddedStructReturn...)
+}
+
+// ExampleMockEmbeddedStructReturnExpectation is an expected call
+// to ExampleMock.EmbeddedStructReturn, registered with OnEmbeddedStructReturn.
+type ExampleMockEmbeddedStructReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockEmbeddedStructReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockEmbeddedStructReturnExpectation) Return(obj struct{ int }) {
+	exp.results = ExampleMockEmbeddedStructReturnResults{Obj: obj}
+}
+
+func (exp *ExampleMockEmbeddedStructReturnExpectation) matches(args ExampleMockEmbeddedStructReturnArgs) bool {
+	return true
+}
+
+// OnEmbeddedStructReturn registers an expected call to EmbeddedStructReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmbeddedStructReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmbeddedStructReturnStub is set.
+func (m *ExampleMock) OnEmbeddedStructReturn() *ExampleMockEmbeddedStructReturnExpectation {
+	return m.expectEmbeddedStructReturn(&ExampleMockEmbeddedStructReturnExpectation{
+		matchers: ExampleMockEmbeddedStructReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectEmbeddedStructReturn(exp *ExampleMockEmbeddedStructReturnExpectation) *ExampleMockEmbeddedStructReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmbeddedStructReturn = append(m.expectationsEmbeddedStructReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmbeddedStructReturn(args ExampleMockEmbeddedStructReturnArgs) *ExampleMockEmbeddedStructReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmbeddedStructReturn = append(m.callsEmbeddedStructReturn, args)
+	for _, exp := range m.expectationsEmbeddedStructReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmbeddedStructReturn) > 0 && m.EmbeddedStructReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmbeddedStructReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmbeddedStructReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmbeddedStructReturnCalledWith fails the test unless EmbeddedStructReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmbeddedStructReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmbeddedStructReturn(&ExampleMockEmbeddedStructReturnExpectation{
+		matchers: ExampleMockEmbeddedStructReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmbeddedStructReturn(exp *ExampleMockEmbeddedStructReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmbeddedStructReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmbeddedStructReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockEmbeddedStructReturnResults holds the results
+// of a call to ExampleMock.EmbeddedStructReturn.
+type ExampleMockEmbeddedStructReturnResults struct {
+	Obj struct{ int }
+}
+
+// EmbeddedStructReturnReturnsSequence sets EmbeddedStructReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) EmbeddedStructReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockEmbeddedStructReturnResults) {
+	var calls int32
+	m.EmbeddedStructReturnStub = func() struct{ int } {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("EmbeddedStructReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Obj
+	}
+}
+
+// EmptyInterfaceReturn is a stub for the Example.EmptyInterfaceReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmptyInterfaceReturn() (intf interface{}) {
+	atomic.AddInt32(&m.EmptyInterfaceReturnCalled, 1)
+	if exp := m.recordEmptyInterfaceReturn(ExampleMockEmptyInterfaceReturnArgs{}); exp != nil {
+		return exp.results.Intf
+	}
+	if m.EmptyInterfaceReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("EmptyInterfaceReturnStub is nil")
+		}
+		panic("EmptyInterfaceReturn unimplemented")
+	}
+	return m.EmptyInterfaceReturnStub()
+}
+
+// ExampleMockEmptyInterfaceReturnArgs holds the arguments
+// of a call to ExampleMock.EmptyInterfaceReturn.
+type ExampleMockEmptyInterfaceReturnArgs struct {
+}
+
+func (args ExampleMockEmptyInterfaceReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmptyInterfaceReturnArgs, rather than of the mock, so that the params of EmptyInterfaceReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockEmptyInterfaceReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// EmptyInterfaceReturnCalls returns the arguments of each call
+// made to EmptyInterfaceReturn so far.
+func (m *ExampleMock) EmptyInterfaceReturnCalls() []ExampleMockEmptyInterfaceReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmptyInterfaceReturnArgs(nil), m.callsEmptyInterfaceReturn...)
+}
+
+// ExampleMockEmptyInterfaceReturnExpectation is an expected call
+// to ExampleMock.EmptyInterfaceReturn, registered with OnEmptyInterfaceReturn.
+type ExampleMockEmptyInterfaceReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockEmptyInterfaceReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockEmptyInterfaceReturnExpectation) Return(intf interface{}) {
+	exp.results = ExampleMockEmptyInterfaceReturnResults{Intf: intf}
+}
+
+func (exp *ExampleMockEmptyInterfaceReturnExpectation) matches(args ExampleMockEmptyInterfaceReturnArgs) bool {
+	return true
+}
+
+// OnEmptyInterfaceReturn registers an expected call to EmptyInterfaceReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmptyInterfaceReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmptyInterfaceReturnStub is set.
+func (m *ExampleMock) OnEmptyInterfaceReturn() *ExampleMockEmptyInterfaceReturnExpectation {
+	return m.expectEmptyInterfaceReturn(&ExampleMockEmptyInterfaceReturnExpectation{
+		matchers: ExampleMockEmptyInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectEmptyInterfaceReturn(exp *ExampleMockEmptyInterfaceReturnExpectation) *ExampleMockEmptyInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmptyInterfaceReturn = append(m.expectationsEmptyInterfaceReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmptyInterfaceReturn(args ExampleMockEmptyInterfaceReturnArgs) *ExampleMockEmptyInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmptyInterfaceReturn = append(m.callsEmptyInterfaceReturn, args)
+	for _, exp := range m.expectationsEmptyInterfaceReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmptyInterfaceReturn) > 0 && m.EmptyInterfaceReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmptyInterfaceReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmptyInterfaceReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmptyInterfaceReturnCalledWith fails the test unless EmptyInterfaceReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmptyInterfaceReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmptyInterfaceReturn(&ExampleMockEmptyInterfaceReturnExpectation{
+		matchers: ExampleMockEmptyInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmptyInterfaceReturn(exp *ExampleMockEmptyInterfaceReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmptyInterfaceReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmptyInterfaceReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockEmptyInterfaceReturnResults holds the results
+// of a call to ExampleMock.EmptyInterfaceReturn.
+type ExampleMockEmptyInterfaceReturnResults struct {
+	Intf interface{}
+}
+
+// EmptyInterfaceReturnReturnsSequence sets EmptyInterfaceReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) EmptyInterfaceReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockEmptyInterfaceReturnResults) {
+	var calls int32
+	m.EmptyInterfaceReturnStub = func() interface{} {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("EmptyInterfaceReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Intf
+	}
+}
+
+// InterfaceReturn is a stub for the Example.InterfaceReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceReturn() (intf interface{ MyFunc(num int) error }) {
+	atomic.AddInt32(&m.InterfaceReturnCalled, 1)
+	if exp := m.recordInterfaceReturn(ExampleMockInterfaceReturnArgs{}); exp != nil {
+		return exp.results.Intf
+	}
+	if m.InterfaceReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceReturnStub is nil")
+		}
+		panic("InterfaceReturn unimplemented")
+	}
+	return m.InterfaceReturnStub()
+}
+
+// ExampleMockInterfaceReturnArgs holds the arguments
+// of a call to ExampleMock.InterfaceReturn.
+type ExampleMockInterfaceReturnArgs struct {
+}
+
+func (args ExampleMockInterfaceReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceReturnArgs, rather than of the mock, so that the params of InterfaceReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// InterfaceReturnCalls returns the arguments of each call
+// made to InterfaceReturn so far.
+func (m *ExampleMock) InterfaceReturnCalls() []ExampleMockInterfaceReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceReturnArgs(nil), m.callsInterfaceReturn...)
+}
+
+// ExampleMockInterfaceReturnExpectation is an expected call
+// to ExampleMock.InterfaceReturn, registered with OnInterfaceReturn.
+type ExampleMockInterfaceReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockInterfaceReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockInterfaceReturnExpectation) Return(intf interface{ MyFunc(num int) error }) {
+	exp.results = ExampleMockInterfaceReturnResults{Intf: intf}
+}
+
+func (exp *ExampleMockInterfaceReturnExpectation) matches(args ExampleMockInterfaceReturnArgs) bool {
+	return true
+}
+
+// OnInterfaceReturn registers an expected call to InterfaceReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceReturnStub is set.
+func (m *ExampleMock) OnInterfaceReturn() *ExampleMockInterfaceReturnExpectation {
+	return m.expectInterfaceReturn(&ExampleMockInterfaceReturnExpectation{
+		matchers: ExampleMockInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceReturn(exp *ExampleMockInterfaceReturnExpectation) *ExampleMockInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceReturn = append(m.expectationsInterfaceReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceReturn(args ExampleMockInterfaceReturnArgs) *ExampleMockInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceReturn = append(m.callsInterfaceReturn, args)
+	for _, exp := range m.expectationsInterfaceReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceReturn) > 0 && m.InterfaceReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceReturnCalledWith fails the test unless InterfaceReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceReturn(&ExampleMockInterfaceReturnExpectation{
+		matchers: ExampleMockInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceReturn(exp *ExampleMockInterfaceReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockInterfaceReturnResults holds the results
+// of a call to ExampleMock.InterfaceReturn.
+type ExampleMockInterfaceReturnResults struct {
+	Intf interface{ MyFunc(num int) error }
+}
+
+// InterfaceReturnReturnsSequence sets InterfaceReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) InterfaceReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockInterfaceReturnResults) {
+	var calls int32
+	m.InterfaceReturnStub = func() interface{ MyFunc(num int) error } {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("InterfaceReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Intf
+	}
+}
+
+// InterfaceVariadicFuncReturn is a stub for the Example.InterfaceVariadicFuncReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) InterfaceVariadicFuncReturn() (intf interface{ MyFunc(nums ...int) error }) {
+	atomic.AddInt32(&m.InterfaceVariadicFuncReturnCalled, 1)
+	if exp := m.recordInterfaceVariadicFuncReturn(ExampleMockInterfaceVariadicFuncReturnArgs{}); exp != nil {
+		return exp.results.Intf
+	}
+	if m.InterfaceVariadicFuncReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("InterfaceVariadicFuncReturnStub is nil")
+		}
+		panic("InterfaceVariadicFuncReturn unimplemented")
+	}
+	return m.InterfaceVariadicFuncReturnStub()
+}
+
+// ExampleMockInterfaceVariadicFuncReturnArgs holds the arguments
+// of a call to ExampleMock.InterfaceVariadicFuncReturn.
+type ExampleMockInterfaceVariadicFuncReturnArgs struct {
+}
+
+func (args ExampleMockInterfaceVariadicFuncReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockInterfaceVariadicFuncReturnArgs, rather than of the mock, so that the params of InterfaceVariadicFuncReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockInterfaceVariadicFuncReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// InterfaceVariadicFuncReturnCalls returns the arguments of each call
+// made to InterfaceVariadicFuncReturn so far.
+func (m *ExampleMock) InterfaceVariadicFuncReturnCalls() []ExampleMockInterfaceVariadicFuncReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockInterfaceVariadicFuncReturnArgs(nil), m.callsInterfaceVariadicFuncReturn...)
+}
+
+// ExampleMockInterfaceVariadicFuncReturnExpectation is an expected call
+// to ExampleMock.InterfaceVariadicFuncReturn, registered with OnInterfaceVariadicFuncReturn.
+type ExampleMockInterfaceVariadicFuncReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockInterfaceVariadicFuncReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockInterfaceVariadicFuncReturnExpectation) Return(intf interface{ MyFunc(nums ...int) error }) {
+	exp.results = ExampleMockInterfaceVariadicFuncReturnResults{Intf: intf}
+}
+
+func (exp *ExampleMockInterfaceVariadicFuncReturnExpectation) matches(args ExampleMockInterfaceVariadicFuncReturnArgs) bool {
+	return true
+}
+
+// OnInterfaceVariadicFuncReturn registers an expected call to InterfaceVariadicFuncReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling InterfaceVariadicFuncReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless InterfaceVariadicFuncReturnStub is set.
+func (m *ExampleMock) OnInterfaceVariadicFuncReturn() *ExampleMockInterfaceVariadicFuncReturnExpectation {
+	return m.expectInterfaceVariadicFuncReturn(&ExampleMockInterfaceVariadicFuncReturnExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectInterfaceVariadicFuncReturn(exp *ExampleMockInterfaceVariadicFuncReturnExpectation) *ExampleMockInterfaceVariadicFuncReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsInterfaceVariadicFuncReturn = append(m.expectationsInterfaceVariadicFuncReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordInterfaceVariadicFuncReturn(args ExampleMockInterfaceVariadicFuncReturnArgs) *ExampleMockInterfaceVariadicFuncReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsInterfaceVariadicFuncReturn = append(m.callsInterfaceVariadicFuncReturn, args)
+	for _, exp := range m.expectationsInterfaceVariadicFuncReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsInterfaceVariadicFuncReturn) > 0 && m.InterfaceVariadicFuncReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsInterfaceVariadicFuncReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("InterfaceVariadicFuncReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertInterfaceVariadicFuncReturnCalledWith fails the test unless InterfaceVariadicFuncReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertInterfaceVariadicFuncReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithInterfaceVariadicFuncReturn(&ExampleMockInterfaceVariadicFuncReturnExpectation{
+		matchers: ExampleMockInterfaceVariadicFuncReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithInterfaceVariadicFuncReturn(exp *ExampleMockInterfaceVariadicFuncReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.InterfaceVariadicFuncReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("InterfaceVariadicFuncReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockInterfaceVariadicFuncReturnResults holds the results
+// of a call to ExampleMock.InterfaceVariadicFuncReturn.
+type ExampleMockInterfaceVariadicFuncReturnResults struct {
+	Intf interface{ MyFunc(nums ...int) error }
+}
+
+// InterfaceVariadicFuncReturnReturnsSequence sets InterfaceVariadicFuncReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) InterfaceVariadicFuncReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockInterfaceVariadicFuncReturnResults) {
+	var calls int32
+	m.InterfaceVariadicFuncReturnStub = func() interface{ MyFunc(nums ...int) error } {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("InterfaceVariadicFuncReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Intf
+	}
+}
+
+// EmbeddedInterfaceReturn is a stub for the Example.EmbeddedInterfaceReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) EmbeddedInterfaceReturn() (intf interface{ fmt.Stringer }) {
+	atomic.AddInt32(&m.EmbeddedInterfaceReturnCalled, 1)
+	if exp := m.recordEmbeddedInterfaceReturn(ExampleMockEmbeddedInterfaceReturnArgs{}); exp != nil {
+		return exp.results.Intf
+	}
+	if m.EmbeddedInterfaceReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("EmbeddedInterfaceReturnStub is nil")
+		}
+		panic("EmbeddedInterfaceReturn unimplemented")
+	}
+	return m.EmbeddedInterfaceReturnStub()
+}
+
+// ExampleMockEmbeddedInterfaceReturnArgs holds the arguments
+// of a call to ExampleMock.EmbeddedInterfaceReturn.
+type ExampleMockEmbeddedInterfaceReturnArgs struct {
+}
+
+func (args ExampleMockEmbeddedInterfaceReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockEmbeddedInterfaceReturnArgs, rather than of the mock, so that the params of EmbeddedInterfaceReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockEmbeddedInterfaceReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// EmbeddedInterfaceReturnCalls returns the arguments of each call
+// made to EmbeddedInterfaceReturn so far.
+func (m *ExampleMock) EmbeddedInterfaceReturnCalls() []ExampleMockEmbeddedInterfaceReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockEmbeddedInterfaceReturnArgs(nil), m.callsEmbeddedInterfaceReturn...)
+}
+
+// ExampleMockEmbeddedInterfaceReturnExpectation is an expected call
+// to ExampleMock.EmbeddedInterfaceReturn, registered with OnEmbeddedInterfaceReturn.
+type ExampleMockEmbeddedInterfaceReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockEmbeddedInterfaceReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockEmbeddedInterfaceReturnExpectation) Return(intf interface{ fmt.Stringer }) {
+	exp.results = ExampleMockEmbeddedInterfaceReturnResults{Intf: intf}
+}
+
+func (exp *ExampleMockEmbeddedInterfaceReturnExpectation) matches(args ExampleMockEmbeddedInterfaceReturnArgs) bool {
+	return true
+}
+
+// OnEmbeddedInterfaceReturn registers an expected call to EmbeddedInterfaceReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling EmbeddedInterfaceReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless EmbeddedInterfaceReturnStub is set.
+func (m *ExampleMock) OnEmbeddedInterfaceReturn() *ExampleMockEmbeddedInterfaceReturnExpectation {
+	return m.expectEmbeddedInterfaceReturn(&ExampleMockEmbeddedInterfaceReturnExpectation{
+		matchers: ExampleMockEmbeddedInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectEmbeddedInterfaceReturn(exp *ExampleMockEmbeddedInterfaceReturnExpectation) *ExampleMockEmbeddedInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsEmbeddedInterfaceReturn = append(m.expectationsEmbeddedInterfaceReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordEmbeddedInterfaceReturn(args ExampleMockEmbeddedInterfaceReturnArgs) *ExampleMockEmbeddedInterfaceReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsEmbeddedInterfaceReturn = append(m.callsEmbeddedInterfaceReturn, args)
+	for _, exp := range m.expectationsEmbeddedInterfaceReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsEmbeddedInterfaceReturn) > 0 && m.EmbeddedInterfaceReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsEmbeddedInterfaceReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("EmbeddedInterfaceReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertEmbeddedInterfaceReturnCalledWith fails the test unless EmbeddedInterfaceReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertEmbeddedInterfaceReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithEmbeddedInterfaceReturn(&ExampleMockEmbeddedInterfaceReturnExpectation{
+		matchers: ExampleMockEmbeddedInterfaceReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithEmbeddedInterfaceReturn(exp *ExampleMockEmbeddedInterfaceReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.EmbeddedInterfaceReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("EmbeddedInterfaceReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockEmbeddedInterfaceReturnResults holds the results
+// of a call to ExampleMock.EmbeddedInterfaceReturn.
+type ExampleMockEmbeddedInterfaceReturnResults struct {
+	Intf interface{ fmt.Stringer }
+}
+
+// EmbeddedInterfaceReturnReturnsSequence sets EmbeddedInterfaceReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) EmbeddedInterfaceReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockEmbeddedInterfaceReturnResults) {
+	var calls int32
+	m.EmbeddedInterfaceReturnStub = func() interface{ fmt.Stringer } {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("EmbeddedInterfaceReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Intf
+	}
+}
+
+// ChanReturn is a stub for the Example.ChanReturn
+// method that records the number of times it has been called.
+func (m *ExampleMock) ChanReturn() (ch <-chan int) {
+	atomic.AddInt32(&m.ChanReturnCalled, 1)
+	if exp := m.recordChanReturn(ExampleMockChanReturnArgs{}); exp != nil {
+		return exp.results.Ch
+	}
+	if m.ChanReturnStub == nil {
+		if m.T != nil {
+			m.T.Error("ChanReturnStub is nil")
+		}
+		panic("ChanReturn unimplemented")
+	}
+	return m.ChanReturnStub()
+}
+
+// ExampleMockChanReturnArgs holds the arguments
+// of a call to ExampleMock.ChanReturn.
+type ExampleMockChanReturnArgs struct {
+}
+
+func (args ExampleMockChanReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockChanReturnArgs, rather than of the mock, so that the params of ChanReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockChanReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// ChanReturnCalls returns the arguments of each call
+// made to ChanReturn so far.
+func (m *ExampleMock) ChanReturnCalls() []ExampleMockChanReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockChanReturnArgs(nil), m.callsChanReturn...)
+}
+
+// ExampleMockChanReturnExpectation is an expected call
+// to ExampleMock.ChanReturn, registered with OnChanReturn.
+type ExampleMockChanReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockChanReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockChanReturnExpectation) Return(ch <-chan int) {
+	exp.results = ExampleMockChanReturnResults{Ch: ch}
+}
+
+func (exp *ExampleMockChanReturnExpectation) matches(args ExampleMockChanReturnArgs) bool {
+	return true
+}
+
+// OnChanReturn registers an expected call to ChanReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ChanReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ChanReturnStub is set.
+func (m *ExampleMock) OnChanReturn() *ExampleMockChanReturnExpectation {
+	return m.expectChanReturn(&ExampleMockChanReturnExpectation{
+		matchers: ExampleMockChanReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectChanReturn(exp *ExampleMockChanReturnExpectation) *ExampleMockChanReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsChanReturn = append(m.expectationsChanReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordChanReturn(args ExampleMockChanReturnArgs) *ExampleMockChanReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsChanReturn = append(m.callsChanReturn, args)
+	for _, exp := range m.expectationsChanReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsChanReturn) > 0 && m.ChanReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsChanReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("ChanReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertChanReturnCalledWith fails the test unless ChanReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *ExampleMock) AssertChanReturnCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithChanReturn(&ExampleMockChanReturnExpectation{
+		matchers: ExampleMockChanReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithChanReturn(exp *ExampleMockChanReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ChanReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("ChanReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockChanReturnResults holds the results
+// of a call to ExampleMock.ChanReturn.
+type ExampleMockChanReturnResults struct {
+	Ch <-chan int
+}
+
+// ChanReturnReturnsSequence sets ChanReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) ChanReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockChanReturnResults) {
+	var calls int32
+	m.ChanReturnStub = func() <-chan int {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("ChanReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Ch
+	}
+}
+
+// MapReturn is a stub for the Example.MapReturn
+// method that records the number of times it has been called.
+func (m_ *ExampleMock) MapReturn() (m map[string]int) {
+	atomic.AddInt32(&m_.MapReturnCalled, 1)
+	if exp := m_.recordMapReturn(ExampleMockMapReturnArgs{}); exp != nil {
+		return exp.results.M
+	}
+	if m_.MapReturnStub == nil {
+		if m_.T != nil {
+			m_.T.Error("MapReturnStub is nil")
+		}
+		panic("MapReturn unimplemented")
+	}
+	return m_.MapReturnStub()
+}
+
+// ExampleMockMapReturnArgs holds the arguments
+// of a call to ExampleMock.MapReturn.
+type ExampleMockMapReturnArgs struct {
+}
+
+func (args ExampleMockMapReturnArgs) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// ExampleMockMapReturnArgs, rather than of the mock, so that the params of MapReturn
+// can't shadow the match package or the params' types.
+func (ExampleMockMapReturnArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// MapReturnCalls returns the arguments of each call
+// made to MapReturn so far.
+func (m *ExampleMock) MapReturnCalls() []ExampleMockMapReturnArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ExampleMockMapReturnArgs(nil), m.callsMapReturn...)
+}
+
+// ExampleMockMapReturnExpectation is an expected call
+// to ExampleMock.MapReturn, registered with OnMapReturn.
+type ExampleMockMapReturnExpectation struct {
+	matchers []match.Matcher
+	results  ExampleMockMapReturnResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *ExampleMockMapReturnExpectation) Return(m map[string]int) {
+	exp.results = ExampleMockMapReturnResults{M: m}
+}
+
+func (exp *ExampleMockMapReturnExpectation) matches(args ExampleMockMapReturnArgs) bool {
+	return true
+}
+
+// OnMapReturn registers an expected call to MapReturn, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling MapReturnStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless MapReturnStub is set.
+func (m_ *ExampleMock) OnMapReturn() *ExampleMockMapReturnExpectation {
+	return m_.expectMapReturn(&ExampleMockMapReturnExpectation{
+		matchers: ExampleMockMapReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) expectMapReturn(exp *ExampleMockMapReturnExpectation) *ExampleMockMapReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsMapReturn = append(m.expectationsMapReturn, exp)
+	return exp
+}
+
+func (m *ExampleMock) recordMapReturn(args ExampleMockMapReturnArgs) *ExampleMockMapReturnExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsMapReturn = append(m.callsMapReturn, args)
+	for _, exp := range m.expectationsMapReturn {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsMapReturn) > 0 && m.MapReturnStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsMapReturn {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("MapReturn", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertMapReturnCalledWith fails the test unless MapReturn has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m_ *ExampleMock) AssertMapReturnCalledWith() bool {
+	if m_.T != nil {
+		m_.T.Helper()
+	}
+	return m_.assertCalledWithMapReturn(&ExampleMockMapReturnExpectation{
+		matchers: ExampleMockMapReturnArgs{}.matchers(),
+	})
+}
+
+func (m *ExampleMock) assertCalledWithMapReturn(exp *ExampleMockMapReturnExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.MapReturnCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("MapReturn", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// ExampleMockMapReturnResults holds the results
+// of a call to ExampleMock.MapReturn.
+type ExampleMockMapReturnResults struct {
+	M map[string]int
+}
+
+// MapReturnReturnsSequence sets MapReturnStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *ExampleMock) MapReturnReturnsSequence(policy sequence.Policy, results ...ExampleMockMapReturnResults) {
+	var calls int32
+	m.MapReturnStub = func() map[string]int {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("MapReturn called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].M
+	}
+}
+
+// StubUsage returns the usage of each of the stubs that are set, and each
+// of the registered expectations, so that the ones that are never called
+// can be reported (see usage.Check).
+func (m *ExampleMock) StubUsage() []usage.Stub {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var stubs []usage.Stub
+
+	// Calls that don't match an expectation are made to the stub
+	{
+		calls := int(atomic.LoadInt32(&m.NoParamsOrReturnCalled))
+		for _, exp := range m.expectationsNoParamsOrReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "NoParamsOrReturn",
+				Expectation: match.Describe("NoParamsOrReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnNoParamsOrReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.NoParamsOrReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "NoParamsOrReturn", Field: "NoParamsOrReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.UnnamedParamCalled))
+		for _, exp := range m.expectationsUnnamedParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "UnnamedParam",
+				Expectation: match.Describe("UnnamedParam", []string{"param1"}, exp.matchers),
+				OnMethod:    "OnUnnamedParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.UnnamedParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "UnnamedParam", Field: "UnnamedParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.UnnamedVariadicParamCalled))
+		for _, exp := range m.expectationsUnnamedVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "UnnamedVariadicParam",
+				Expectation: match.Describe("UnnamedVariadicParam", []string{"param1"}, exp.matchers),
+				OnMethod:    "OnUnnamedVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.UnnamedVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "UnnamedVariadicParam", Field: "UnnamedVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.BlankParamCalled))
+		for _, exp := range m.expectationsBlankParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "BlankParam",
+				Expectation: match.Describe("BlankParam", []string{"param1"}, exp.matchers),
+				OnMethod:    "OnBlankParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.BlankParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "BlankParam", Field: "BlankParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.BlankVariadicParamCalled))
+		for _, exp := range m.expectationsBlankVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "BlankVariadicParam",
+				Expectation: match.Describe("BlankVariadicParam", []string{"param1"}, exp.matchers),
+				OnMethod:    "OnBlankVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.BlankVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "BlankVariadicParam", Field: "BlankVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.NamedParamCalled))
+		for _, exp := range m.expectationsNamedParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "NamedParam",
+				Expectation: match.Describe("NamedParam", []string{"str"}, exp.matchers),
+				OnMethod:    "OnNamedParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.NamedParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "NamedParam", Field: "NamedParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.NamedVariadicParamCalled))
+		for _, exp := range m.expectationsNamedVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "NamedVariadicParam",
+				Expectation: match.Describe("NamedVariadicParam", []string{"strs"}, exp.matchers),
+				OnMethod:    "OnNamedVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.NamedVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "NamedVariadicParam", Field: "NamedVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.SameTypeNamedParamsCalled))
+		for _, exp := range m.expectationsSameTypeNamedParams {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "SameTypeNamedParams",
+				Expectation: match.Describe("SameTypeNamedParams", []string{"str1", "str2"}, exp.matchers),
+				OnMethod:    "OnSameTypeNamedParams",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.SameTypeNamedParamsStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "SameTypeNamedParams", Field: "SameTypeNamedParamsStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InternalTypeParamCalled))
+		for _, exp := range m.expectationsInternalTypeParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InternalTypeParam",
+				Expectation: match.Describe("InternalTypeParam", []string{"internal"}, exp.matchers),
+				OnMethod:    "OnInternalTypeParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InternalTypeParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InternalTypeParam", Field: "InternalTypeParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ImportedParamCalled))
+		for _, exp := range m.expectationsImportedParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "ImportedParam",
+				Expectation: match.Describe("ImportedParam", []string{"tmpl"}, exp.matchers),
+				OnMethod:    "OnImportedParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ImportedParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "ImportedParam", Field: "ImportedParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ImportedVariadicParamCalled))
+		for _, exp := range m.expectationsImportedVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "ImportedVariadicParam",
+				Expectation: match.Describe("ImportedVariadicParam", []string{"tmpl"}, exp.matchers),
+				OnMethod:    "OnImportedVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ImportedVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "ImportedVariadicParam", Field: "ImportedVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.RenamedImportParamCalled))
+		for _, exp := range m.expectationsRenamedImportParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "RenamedImportParam",
+				Expectation: match.Describe("RenamedImportParam", []string{"tmpl"}, exp.matchers),
+				OnMethod:    "OnRenamedImportParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.RenamedImportParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "RenamedImportParam", Field: "RenamedImportParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.RenamedImportVariadicParamCalled))
+		for _, exp := range m.expectationsRenamedImportVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "RenamedImportVariadicParam",
+				Expectation: match.Describe("RenamedImportVariadicParam", []string{"tmpls"}, exp.matchers),
+				OnMethod:    "OnRenamedImportVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.RenamedImportVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "RenamedImportVariadicParam", Field: "RenamedImportVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.DotImportParamCalled))
+		for _, exp := range m.expectationsDotImportParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "DotImportParam",
+				Expectation: match.Describe("DotImportParam", []string{"file"}, exp.matchers),
+				OnMethod:    "OnDotImportParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.DotImportParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "DotImportParam", Field: "DotImportParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.DotImportVariadicParamCalled))
+		for _, exp := range m.expectationsDotImportVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "DotImportVariadicParam",
+				Expectation: match.Describe("DotImportVariadicParam", []string{"files"}, exp.matchers),
+				OnMethod:    "OnDotImportVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.DotImportVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "DotImportVariadicParam", Field: "DotImportVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.SelfReferentialParamCalled))
+		for _, exp := range m.expectationsSelfReferentialParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "SelfReferentialParam",
+				Expectation: match.Describe("SelfReferentialParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnSelfReferentialParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.SelfReferentialParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "SelfReferentialParam", Field: "SelfReferentialParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.SelfReferentialVariadicParamCalled))
+		for _, exp := range m.expectationsSelfReferentialVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "SelfReferentialVariadicParam",
+				Expectation: match.Describe("SelfReferentialVariadicParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnSelfReferentialVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.SelfReferentialVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "SelfReferentialVariadicParam", Field: "SelfReferentialVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.StructParamCalled))
+		for _, exp := range m.expectationsStructParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "StructParam",
+				Expectation: match.Describe("StructParam", []string{"obj"}, exp.matchers),
+				OnMethod:    "OnStructParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.StructParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "StructParam", Field: "StructParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.StructVariadicParamCalled))
+		for _, exp := range m.expectationsStructVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "StructVariadicParam",
+				Expectation: match.Describe("StructVariadicParam", []string{"objs"}, exp.matchers),
+				OnMethod:    "OnStructVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.StructVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "StructVariadicParam", Field: "StructVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmbeddedStructParamCalled))
+		for _, exp := range m.expectationsEmbeddedStructParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmbeddedStructParam",
+				Expectation: match.Describe("EmbeddedStructParam", []string{"obj"}, exp.matchers),
+				OnMethod:    "OnEmbeddedStructParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmbeddedStructParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmbeddedStructParam", Field: "EmbeddedStructParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmbeddedStructVariadicParamCalled))
+		for _, exp := range m.expectationsEmbeddedStructVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmbeddedStructVariadicParam",
+				Expectation: match.Describe("EmbeddedStructVariadicParam", []string{"objs"}, exp.matchers),
+				OnMethod:    "OnEmbeddedStructVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmbeddedStructVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmbeddedStructVariadicParam", Field: "EmbeddedStructVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmptyInterfaceParamCalled))
+		for _, exp := range m.expectationsEmptyInterfaceParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmptyInterfaceParam",
+				Expectation: match.Describe("EmptyInterfaceParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnEmptyInterfaceParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmptyInterfaceParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmptyInterfaceParam", Field: "EmptyInterfaceParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmptyInterfaceVariadicParamCalled))
+		for _, exp := range m.expectationsEmptyInterfaceVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmptyInterfaceVariadicParam",
+				Expectation: match.Describe("EmptyInterfaceVariadicParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnEmptyInterfaceVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmptyInterfaceVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmptyInterfaceVariadicParam", Field: "EmptyInterfaceVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceParamCalled))
+		for _, exp := range m.expectationsInterfaceParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceParam",
+				Expectation: match.Describe("InterfaceParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnInterfaceParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceParam", Field: "InterfaceParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceVariadicParamCalled))
+		for _, exp := range m.expectationsInterfaceVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceVariadicParam",
+				Expectation: match.Describe("InterfaceVariadicParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnInterfaceVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceVariadicParam", Field: "InterfaceVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceVariadicFuncParamCalled))
+		for _, exp := range m.expectationsInterfaceVariadicFuncParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceVariadicFuncParam",
+				Expectation: match.Describe("InterfaceVariadicFuncParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnInterfaceVariadicFuncParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceVariadicFuncParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceVariadicFuncParam", Field: "InterfaceVariadicFuncParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceVariadicFuncVariadicParamCalled))
+		for _, exp := range m.expectationsInterfaceVariadicFuncVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceVariadicFuncVariadicParam",
+				Expectation: match.Describe("InterfaceVariadicFuncVariadicParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnInterfaceVariadicFuncVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceVariadicFuncVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceVariadicFuncVariadicParam", Field: "InterfaceVariadicFuncVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmbeddedInterfaceParamCalled))
+		for _, exp := range m.expectationsEmbeddedInterfaceParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmbeddedInterfaceParam",
+				Expectation: match.Describe("EmbeddedInterfaceParam", []string{"intf"}, exp.matchers),
+				OnMethod:    "OnEmbeddedInterfaceParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmbeddedInterfaceParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmbeddedInterfaceParam", Field: "EmbeddedInterfaceParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ChanParamCalled))
+		for _, exp := range m.expectationsChanParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "ChanParam",
+				Expectation: match.Describe("ChanParam", []string{"ch"}, exp.matchers),
+				OnMethod:    "OnChanParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ChanParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "ChanParam", Field: "ChanParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.DirectionalChanParamsCalled))
+		for _, exp := range m.expectationsDirectionalChanParams {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "DirectionalChanParams",
+				Expectation: match.Describe("DirectionalChanParams", []string{"recv", "send"}, exp.matchers),
+				OnMethod:    "OnDirectionalChanParams",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.DirectionalChanParamsStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "DirectionalChanParams", Field: "DirectionalChanParamsStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ChanVariadicParamCalled))
+		for _, exp := range m.expectationsChanVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "ChanVariadicParam",
+				Expectation: match.Describe("ChanVariadicParam", []string{"chs"}, exp.matchers),
+				OnMethod:    "OnChanVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ChanVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "ChanVariadicParam", Field: "ChanVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.MapParamCalled))
+		for _, exp := range m.expectationsMapParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "MapParam",
+				Expectation: match.Describe("MapParam", []string{"m"}, exp.matchers),
+				OnMethod:    "OnMapParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.MapParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "MapParam", Field: "MapParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.MapVariadicParamCalled))
+		for _, exp := range m.expectationsMapVariadicParam {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "MapVariadicParam",
+				Expectation: match.Describe("MapVariadicParam", []string{"ms"}, exp.matchers),
+				OnMethod:    "OnMapVariadicParam",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.MapVariadicParamStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "MapVariadicParam", Field: "MapVariadicParamStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.UnnamedReturnCalled))
+		for _, exp := range m.expectationsUnnamedReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "UnnamedReturn",
+				Expectation: match.Describe("UnnamedReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnUnnamedReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.UnnamedReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "UnnamedReturn", Field: "UnnamedReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.MultipleUnnamedReturnCalled))
+		for _, exp := range m.expectationsMultipleUnnamedReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "MultipleUnnamedReturn",
+				Expectation: match.Describe("MultipleUnnamedReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnMultipleUnnamedReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.MultipleUnnamedReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "MultipleUnnamedReturn", Field: "MultipleUnnamedReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.BlankReturnCalled))
+		for _, exp := range m.expectationsBlankReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "BlankReturn",
+				Expectation: match.Describe("BlankReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnBlankReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.BlankReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "BlankReturn", Field: "BlankReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.NamedReturnCalled))
+		for _, exp := range m.expectationsNamedReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "NamedReturn",
+				Expectation: match.Describe("NamedReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnNamedReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.NamedReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "NamedReturn", Field: "NamedReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.SameTypeNamedReturnCalled))
+		for _, exp := range m.expectationsSameTypeNamedReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "SameTypeNamedReturn",
+				Expectation: match.Describe("SameTypeNamedReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnSameTypeNamedReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.SameTypeNamedReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "SameTypeNamedReturn", Field: "SameTypeNamedReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.RenamedImportReturnCalled))
+		for _, exp := range m.expectationsRenamedImportReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "RenamedImportReturn",
+				Expectation: match.Describe("RenamedImportReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnRenamedImportReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.RenamedImportReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "RenamedImportReturn", Field: "RenamedImportReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.DotImportReturnCalled))
+		for _, exp := range m.expectationsDotImportReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "DotImportReturn",
+				Expectation: match.Describe("DotImportReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnDotImportReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.DotImportReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "DotImportReturn", Field: "DotImportReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.SelfReferentialReturnCalled))
+		for _, exp := range m.expectationsSelfReferentialReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "SelfReferentialReturn",
+				Expectation: match.Describe("SelfReferentialReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnSelfReferentialReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.SelfReferentialReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "SelfReferentialReturn", Field: "SelfReferentialReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.StructReturnCalled))
+		for _, exp := range m.expectationsStructReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "StructReturn",
+				Expectation: match.Describe("StructReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnStructReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.StructReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "StructReturn", Field: "StructReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmbeddedStructReturnCalled))
+		for _, exp := range m.expectationsEmbeddedStructReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmbeddedStructReturn",
+				Expectation: match.Describe("EmbeddedStructReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnEmbeddedStructReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmbeddedStructReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmbeddedStructReturn", Field: "EmbeddedStructReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmptyInterfaceReturnCalled))
+		for _, exp := range m.expectationsEmptyInterfaceReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmptyInterfaceReturn",
+				Expectation: match.Describe("EmptyInterfaceReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnEmptyInterfaceReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmptyInterfaceReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmptyInterfaceReturn", Field: "EmptyInterfaceReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceReturnCalled))
+		for _, exp := range m.expectationsInterfaceReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceReturn",
+				Expectation: match.Describe("InterfaceReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnInterfaceReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceReturn", Field: "InterfaceReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.InterfaceVariadicFuncReturnCalled))
+		for _, exp := range m.expectationsInterfaceVariadicFuncReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "InterfaceVariadicFuncReturn",
+				Expectation: match.Describe("InterfaceVariadicFuncReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnInterfaceVariadicFuncReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.InterfaceVariadicFuncReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "InterfaceVariadicFuncReturn", Field: "InterfaceVariadicFuncReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.EmbeddedInterfaceReturnCalled))
+		for _, exp := range m.expectationsEmbeddedInterfaceReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "EmbeddedInterfaceReturn",
+				Expectation: match.Describe("EmbeddedInterfaceReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnEmbeddedInterfaceReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.EmbeddedInterfaceReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "EmbeddedInterfaceReturn", Field: "EmbeddedInterfaceReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ChanReturnCalled))
+		for _, exp := range m.expectationsChanReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "ChanReturn",
+				Expectation: match.Describe("ChanReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnChanReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ChanReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "ChanReturn", Field: "ChanReturnStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.MapReturnCalled))
+		for _, exp := range m.expectationsMapReturn {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "ExampleMock",
+				Method:      "MapReturn",
+				Expectation: match.Describe("MapReturn", []string(nil), exp.matchers),
+				OnMethod:    "OnMapReturn",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.MapReturnStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "ExampleMock", Method: "MapReturn", Field: "MapReturnStub", Calls: calls})
+		}
+	}
+	return stubs
+}
+
+// FailAll wraps the stubs of all of the methods whose last result is an
+// error, so that every call to them fails with the given error (and
+// zero values for any other results).
+func (m *ExampleMock) FailAll(err error) {
+	m.FailUnnamedReturnWith(err, 1)
+	m.FailMultipleUnnamedReturnWith(err, 1)
+	m.FailBlankReturnWith(err, 1)
+	m.FailNamedReturnWith(err, 1)
+	m.FailSameTypeNamedReturnWith(err, 1)
 }
@@ -7,6 +7,10 @@ import "github.com/nathanjcochran/mock/example/internal"
 //
 //go:generate mock -o generic_mock.go Generic
 type Generic[T interface{ byte | internal.Internal }, U any] interface {
+	// GetT returns the current T value.
 	GetT() T
+
+	// GetU returns the current U value, or
+	// the zero value if it hasn't been set.
 	GetU() U
 }
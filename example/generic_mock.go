@@ -1,20 +1,40 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: abf13f91f71d7d9b
+
 package example
 
 import (
+	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 
 	"github.com/nathanjcochran/mock/example/internal"
+	"github.com/nathanjcochran/mock/match"
+	"github.com/nathanjcochran/mock/sequence"
+	"github.com/nathanjcochran/mock/usage"
 )
 
 // GenericMock is a mock implementation of the Generic
 // interface.
+//
+// Generic is a sample generic interface with a complex type
+// parameter list.
 type GenericMock[T interface{ byte | internal.Internal }, U any] struct {
-	T          *testing.T
+	T *testing.T
+	// GetT returns the current T value.
 	GetTStub   func() T
 	GetTCalled int32
+	// GetU returns the current U value, or
+	// the zero value if it hasn't been set.
 	GetUStub   func() U
 	GetUCalled int32
+
+	mu               sync.Mutex
+	callsGetT        []GenericMockGetTArgs[T, U]
+	expectationsGetT []*GenericMockGetTExpectation[T, U]
+	callsGetU        []GenericMockGetUArgs[T, U]
+	expectationsGetU []*GenericMockGetUExpectation[T, U]
 }
 
 // Verify that *GenericMock implements Generic.
@@ -24,8 +44,13 @@ func _[T interface{ byte | internal.Internal }, U any]() {
 
 // GetT is a stub for the Generic.GetT
 // method that records the number of times it has been called.
+//
+// GetT returns the current T value.
 func (m *GenericMock[T, U]) GetT() T {
 	atomic.AddInt32(&m.GetTCalled, 1)
+	if exp := m.recordGetT(GenericMockGetTArgs[T, U]{}); exp != nil {
+		return exp.results.Result1
+	}
 	if m.GetTStub == nil {
 		if m.T != nil {
 			m.T.Error("GetTStub is nil")
@@ -35,10 +60,157 @@ func (m *GenericMock[T, U]) GetT() T {
 	return m.GetTStub()
 }
 
+// GenericMockGetTArgs holds the arguments
+// of a call to GenericMock.GetT.
+type GenericMockGetTArgs[T interface{ byte | internal.Internal }, U any] struct {
+}
+
+func (args GenericMockGetTArgs[T, U]) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// GenericMockGetTArgs[T, U], rather than of the mock, so that the params of GetT
+// can't shadow the match package or the params' types.
+func (GenericMockGetTArgs[T, U]) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// GetTCalls returns the arguments of each call
+// made to GetT so far.
+func (m *GenericMock[T, U]) GetTCalls() []GenericMockGetTArgs[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]GenericMockGetTArgs[T, U](nil), m.callsGetT...)
+}
+
+// GenericMockGetTExpectation is an expected call
+// to GenericMock.GetT, registered with OnGetT.
+type GenericMockGetTExpectation[T interface{ byte | internal.Internal }, U any] struct {
+	matchers []match.Matcher
+	results  GenericMockGetTResults[T, U]
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *GenericMockGetTExpectation[T, U]) Return(result1 T) {
+	exp.results = GenericMockGetTResults[T, U]{Result1: result1}
+}
+
+func (exp *GenericMockGetTExpectation[T, U]) matches(args GenericMockGetTArgs[T, U]) bool {
+	return true
+}
+
+// OnGetT registers an expected call to GetT, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling GetTStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless GetTStub is set.
+func (m *GenericMock[T, U]) OnGetT() *GenericMockGetTExpectation[T, U] {
+	return m.expectGetT(&GenericMockGetTExpectation[T, U]{
+		matchers: GenericMockGetTArgs[T, U]{}.matchers(),
+	})
+}
+
+func (m *GenericMock[T, U]) expectGetT(exp *GenericMockGetTExpectation[T, U]) *GenericMockGetTExpectation[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsGetT = append(m.expectationsGetT, exp)
+	return exp
+}
+
+func (m *GenericMock[T, U]) recordGetT(args GenericMockGetTArgs[T, U]) *GenericMockGetTExpectation[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsGetT = append(m.callsGetT, args)
+	for _, exp := range m.expectationsGetT {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsGetT) > 0 && m.GetTStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsGetT {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("GetT", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertGetTCalledWith fails the test unless GetT has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *GenericMock[T, U]) AssertGetTCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithGetT(&GenericMockGetTExpectation[T, U]{
+		matchers: GenericMockGetTArgs[T, U]{}.matchers(),
+	})
+}
+
+func (m *GenericMock[T, U]) assertCalledWithGetT(exp *GenericMockGetTExpectation[T, U]) bool {
+	var calls []match.Call
+	for _, args := range m.GetTCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("GetT", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// GenericMockGetTResults holds the results
+// of a call to GenericMock.GetT.
+type GenericMockGetTResults[T interface{ byte | internal.Internal }, U any] struct {
+	Result1 T
+}
+
+// GetTReturnsSequence sets GetTStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *GenericMock[T, U]) GetTReturnsSequence(policy sequence.Policy, results ...GenericMockGetTResults[T, U]) {
+	var calls int32
+	m.GetTStub = func() T {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("GetT called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
 // GetU is a stub for the Generic.GetU
 // method that records the number of times it has been called.
+//
+// GetU returns the current U value, or
+// the zero value if it hasn't been set.
 func (m *GenericMock[T, U]) GetU() U {
 	atomic.AddInt32(&m.GetUCalled, 1)
+	if exp := m.recordGetU(GenericMockGetUArgs[T, U]{}); exp != nil {
+		return exp.results.Result1
+	}
 	if m.GetUStub == nil {
 		if m.T != nil {
 			m.T.Error("GetUStub is nil")
@@ -47,3 +219,188 @@ func (m *GenericMock[T, U]) GetU() U {
 	}
 	return m.GetUStub()
 }
+
+// GenericMockGetUArgs holds the arguments
+// of a call to GenericMock.GetU.
+type GenericMockGetUArgs[T interface{ byte | internal.Internal }, U any] struct {
+}
+
+func (args GenericMockGetUArgs[T, U]) call() match.Call {
+	return match.Call{}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// GenericMockGetUArgs[T, U], rather than of the mock, so that the params of GetU
+// can't shadow the match package or the params' types.
+func (GenericMockGetUArgs[T, U]) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{}
+}
+
+// GetUCalls returns the arguments of each call
+// made to GetU so far.
+func (m *GenericMock[T, U]) GetUCalls() []GenericMockGetUArgs[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]GenericMockGetUArgs[T, U](nil), m.callsGetU...)
+}
+
+// GenericMockGetUExpectation is an expected call
+// to GenericMock.GetU, registered with OnGetU.
+type GenericMockGetUExpectation[T interface{ byte | internal.Internal }, U any] struct {
+	matchers []match.Matcher
+	results  GenericMockGetUResults[T, U]
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *GenericMockGetUExpectation[T, U]) Return(result1 U) {
+	exp.results = GenericMockGetUResults[T, U]{Result1: result1}
+}
+
+func (exp *GenericMockGetUExpectation[T, U]) matches(args GenericMockGetUArgs[T, U]) bool {
+	return true
+}
+
+// OnGetU registers an expected call to GetU, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling GetUStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless GetUStub is set.
+func (m *GenericMock[T, U]) OnGetU() *GenericMockGetUExpectation[T, U] {
+	return m.expectGetU(&GenericMockGetUExpectation[T, U]{
+		matchers: GenericMockGetUArgs[T, U]{}.matchers(),
+	})
+}
+
+func (m *GenericMock[T, U]) expectGetU(exp *GenericMockGetUExpectation[T, U]) *GenericMockGetUExpectation[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsGetU = append(m.expectationsGetU, exp)
+	return exp
+}
+
+func (m *GenericMock[T, U]) recordGetU(args GenericMockGetUArgs[T, U]) *GenericMockGetUExpectation[T, U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsGetU = append(m.callsGetU, args)
+	for _, exp := range m.expectationsGetU {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsGetU) > 0 && m.GetUStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsGetU {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("GetU", []string(nil), args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertGetUCalledWith fails the test unless GetU has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *GenericMock[T, U]) AssertGetUCalledWith() bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithGetU(&GenericMockGetUExpectation[T, U]{
+		matchers: GenericMockGetUArgs[T, U]{}.matchers(),
+	})
+}
+
+func (m *GenericMock[T, U]) assertCalledWithGetU(exp *GenericMockGetUExpectation[T, U]) bool {
+	var calls []match.Call
+	for _, args := range m.GetUCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("GetU", []string(nil), exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// GenericMockGetUResults holds the results
+// of a call to GenericMock.GetU.
+type GenericMockGetUResults[T interface{ byte | internal.Internal }, U any] struct {
+	Result1 U
+}
+
+// GetUReturnsSequence sets GetUStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *GenericMock[T, U]) GetUReturnsSequence(policy sequence.Policy, results ...GenericMockGetUResults[T, U]) {
+	var calls int32
+	m.GetUStub = func() U {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("GetU called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
+// StubUsage returns the usage of each of the stubs that are set, and each
+// of the registered expectations, so that the ones that are never called
+// can be reported (see usage.Check).
+func (m *GenericMock[T, U]) StubUsage() []usage.Stub {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var stubs []usage.Stub
+
+	// Calls that don't match an expectation are made to the stub
+	{
+		calls := int(atomic.LoadInt32(&m.GetTCalled))
+		for _, exp := range m.expectationsGetT {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "GenericMock",
+				Method:      "GetT",
+				Expectation: match.Describe("GetT", []string(nil), exp.matchers),
+				OnMethod:    "OnGetT",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.GetTStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "GenericMock", Method: "GetT", Field: "GetTStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.GetUCalled))
+		for _, exp := range m.expectationsGetU {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "GenericMock",
+				Method:      "GetU",
+				Expectation: match.Describe("GetU", []string(nil), exp.matchers),
+				OnMethod:    "OnGetU",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.GetUStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "GenericMock", Method: "GetU", Field: "GetUStub", Calls: calls})
+		}
+	}
+	return stubs
+}
@@ -0,0 +1,32 @@
+package example
+
+import "context"
+
+// Item is an item kept in a Store.
+type Item struct {
+	ID   string
+	Name string
+}
+
+// Store is an example of a repository interface, whose methods
+// take a context and return an error.
+//
+//go:generate mock -o store_mock.go Store
+//go:generate mock -style=fake -o store_fake.go Store
+//go:generate mock -style=logging -o store_logging.go Store
+//go:generate mock -style=metrics -o store_metrics.go Store
+//go:generate mock -style=tracing -o store_tracing.go Store
+//go:generate mock -style=record -o store_record.go Store
+type Store interface {
+	// Get returns the item with the given ID.
+	Get(ctx context.Context, id string) (Item, error)
+
+	// Put creates or replaces an item.
+	Put(ctx context.Context, item Item) error
+
+	// Delete removes the item with the given ID.
+	Delete(ctx context.Context, id string) error
+
+	// List returns all of the items in the store.
+	List(ctx context.Context) ([]Item, error)
+}
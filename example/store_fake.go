@@ -0,0 +1,116 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 71b19d4607b474fd
+
+package example
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrStoreFakeNotFound is the error returned by a StoreFake
+// when no value is stored under a key, unless its NotFound field is set.
+var ErrStoreFakeNotFound = errors.New("StoreFake: not found")
+
+// StoreFake is a stateful, in-memory fake implementation of the
+// Store interface, which stores Item values by string key.
+// Its zero value is an empty fake, ready to use. It is safe for
+// concurrent use.
+//
+// Store is an example of a repository interface, whose methods
+// take a context and return an error.
+type StoreFake struct {
+	// Key returns the key to store a value under, for
+	// methods that store values without an explicit key.
+	Key func(Item) string
+
+	// NotFound is the error returned when no value is stored under
+	// a key (default: ErrStoreFakeNotFound).
+	NotFound error
+
+	mu     sync.RWMutex
+	values map[string]Item
+	keys   []string
+}
+
+// Verify that *StoreFake implements Store.
+var _ Store = &StoreFake{}
+
+func (f *StoreFake) notFound() error {
+	if f.NotFound != nil {
+		return f.NotFound
+	}
+	return ErrStoreFakeNotFound
+}
+
+// Get returns the value stored under the key,
+// or the NotFound error if there is none.
+//
+// Get returns the item with the given ID.
+func (f *StoreFake) Get(ctx context.Context, id string) (result1 Item, result2 error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	var ok bool
+	result1, ok = f.values[id]
+	if !ok {
+		result2 = f.notFound()
+	}
+	return result1, result2
+}
+
+// Put stores the value under the key returned by Key, replacing any
+// value already stored under it.
+//
+// Put creates or replaces an item.
+func (f *StoreFake) Put(ctx context.Context, item Item) (result1 error) {
+	if f.Key == nil {
+		panic("StoreFake.Key is nil")
+	}
+	key := f.Key(item)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.values == nil {
+		f.values = map[string]Item{}
+	}
+	if _, ok := f.values[key]; !ok {
+		f.keys = append(f.keys, key)
+	}
+	f.values[key] = item
+	return result1
+}
+
+// Delete removes the value stored under the key,
+// or returns the NotFound error if there is none.
+//
+// Delete removes the item with the given ID.
+func (f *StoreFake) Delete(ctx context.Context, id string) (result1 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.values[id]; !ok {
+		result1 = f.notFound()
+		return result1
+	}
+	delete(f.values, id)
+	for i := range f.keys {
+		if f.keys[i] == id {
+			f.keys = append(f.keys[:i], f.keys[i+1:]...)
+			break
+		}
+	}
+	return result1
+}
+
+// List returns all of the stored values,
+// in the order they were first stored.
+//
+// List returns all of the items in the store.
+func (f *StoreFake) List(ctx context.Context) (result1 []Item, result2 error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	result1 = make([]Item, 0, len(f.keys))
+	for _, key := range f.keys {
+		result1 = append(result1, f.values[key])
+	}
+	return result1, result2
+}
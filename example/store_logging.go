@@ -0,0 +1,95 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 0d04834b15c046d3
+
+package example
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// StoreLogging is a decorator for the Store interface
+// that logs each method call, along with its arguments and results.
+type StoreLogging struct {
+	Next   Store
+	Logger *slog.Logger
+	Level  slog.Level
+}
+
+// NewStoreLogging returns a StoreLogging decorator that logs
+// calls to next at the default (info) level.
+func NewStoreLogging(next Store, logger *slog.Logger) *StoreLogging {
+	return &StoreLogging{Next: next, Logger: logger}
+}
+
+// Verify that *StoreLogging implements Store.
+var _ Store = &StoreLogging{}
+
+// Get logs the call, delegates it to the underlying Store,
+// and logs its results.
+//
+// Get returns the item with the given ID.
+func (dec *StoreLogging) Get(ctx context.Context, id string) (result1 Item, result2 error) {
+	dec.Logger.Log(ctx, dec.Level, "calling Store.Get", "id", id)
+	startTime := time.Now()
+	result1, result2 = dec.Next.Get(ctx, id)
+	if result2 != nil {
+		dec.Logger.Log(ctx, slog.LevelError, "Store.Get failed",
+			"error", result2, "duration", time.Since(startTime))
+		return result1, result2
+	}
+	dec.Logger.Log(ctx, dec.Level, "Store.Get returned", "result1", result1, "duration", time.Since(startTime))
+	return result1, result2
+}
+
+// Put logs the call, delegates it to the underlying Store,
+// and logs its results.
+//
+// Put creates or replaces an item.
+func (dec *StoreLogging) Put(ctx context.Context, item Item) (result1 error) {
+	dec.Logger.Log(ctx, dec.Level, "calling Store.Put", "item", item)
+	startTime := time.Now()
+	result1 = dec.Next.Put(ctx, item)
+	if result1 != nil {
+		dec.Logger.Log(ctx, slog.LevelError, "Store.Put failed",
+			"error", result1, "duration", time.Since(startTime))
+		return result1
+	}
+	dec.Logger.Log(ctx, dec.Level, "Store.Put returned", "duration", time.Since(startTime))
+	return result1
+}
+
+// Delete logs the call, delegates it to the underlying Store,
+// and logs its results.
+//
+// Delete removes the item with the given ID.
+func (dec *StoreLogging) Delete(ctx context.Context, id string) (result1 error) {
+	dec.Logger.Log(ctx, dec.Level, "calling Store.Delete", "id", id)
+	startTime := time.Now()
+	result1 = dec.Next.Delete(ctx, id)
+	if result1 != nil {
+		dec.Logger.Log(ctx, slog.LevelError, "Store.Delete failed",
+			"error", result1, "duration", time.Since(startTime))
+		return result1
+	}
+	dec.Logger.Log(ctx, dec.Level, "Store.Delete returned", "duration", time.Since(startTime))
+	return result1
+}
+
+// List logs the call, delegates it to the underlying Store,
+// and logs its results.
+//
+// List returns all of the items in the store.
+func (dec *StoreLogging) List(ctx context.Context) (result1 []Item, result2 error) {
+	dec.Logger.Log(ctx, dec.Level, "calling Store.List")
+	startTime := time.Now()
+	result1, result2 = dec.Next.List(ctx)
+	if result2 != nil {
+		dec.Logger.Log(ctx, slog.LevelError, "Store.List failed",
+			"error", result2, "duration", time.Since(startTime))
+		return result1, result2
+	}
+	dec.Logger.Log(ctx, dec.Level, "Store.List returned", "result1", result1, "duration", time.Since(startTime))
+	return result1, result2
+}
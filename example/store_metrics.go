@@ -0,0 +1,75 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 913945179c19a269
+
+package example
+
+import (
+	"context"
+	"time"
+)
+
+// StoreMetricsRecorder records the duration and outcome of
+// each call made through a StoreMetrics decorator.
+type StoreMetricsRecorder interface {
+	RecordCall(method string, duration time.Duration, err error)
+}
+
+// StoreMetrics is a decorator for the Store interface
+// that times each method call and reports it to a recorder.
+type StoreMetrics struct {
+	Next     Store
+	Recorder StoreMetricsRecorder
+}
+
+// NewStoreMetrics returns a StoreMetrics decorator that
+// reports the calls made to next to recorder.
+func NewStoreMetrics(next Store, recorder StoreMetricsRecorder) *StoreMetrics {
+	return &StoreMetrics{Next: next, Recorder: recorder}
+}
+
+// Verify that *StoreMetrics implements Store.
+var _ Store = &StoreMetrics{}
+
+// Get delegates the call to the underlying Store,
+// and records how long it took.
+//
+// Get returns the item with the given ID.
+func (dec *StoreMetrics) Get(ctx context.Context, id string) (result1 Item, result2 error) {
+	startTime := time.Now()
+	result1, result2 = dec.Next.Get(ctx, id)
+	dec.Recorder.RecordCall("Get", time.Since(startTime), result2)
+	return result1, result2
+}
+
+// Put delegates the call to the underlying Store,
+// and records how long it took.
+//
+// Put creates or replaces an item.
+func (dec *StoreMetrics) Put(ctx context.Context, item Item) (result1 error) {
+	startTime := time.Now()
+	result1 = dec.Next.Put(ctx, item)
+	dec.Recorder.RecordCall("Put", time.Since(startTime), result1)
+	return result1
+}
+
+// Delete delegates the call to the underlying Store,
+// and records how long it took.
+//
+// Delete removes the item with the given ID.
+func (dec *StoreMetrics) Delete(ctx context.Context, id string) (result1 error) {
+	startTime := time.Now()
+	result1 = dec.Next.Delete(ctx, id)
+	dec.Recorder.RecordCall("Delete", time.Since(startTime), result1)
+	return result1
+}
+
+// List delegates the call to the underlying Store,
+// and records how long it took.
+//
+// List returns all of the items in the store.
+func (dec *StoreMetrics) List(ctx context.Context) (result1 []Item, result2 error) {
+	startTime := time.Now()
+	result1, result2 = dec.Next.List(ctx)
+	dec.Recorder.RecordCall("List", time.Since(startTime), result2)
+	return result1, result2
+}
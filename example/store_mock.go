@@ -0,0 +1,1070 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 799f49e4809a57f1
+
+package example
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nathanjcochran/mock/match"
+	"github.com/nathanjcochran/mock/sequence"
+	"github.com/nathanjcochran/mock/usage"
+)
+
+// StoreMock is a mock implementation of the Store
+// interface.
+//
+// Store is an example of a repository interface, whose methods
+// take a context and return an error.
+type StoreMock struct {
+	T *testing.T
+	// Get returns the item with the given ID.
+	GetStub   func(ctx context.Context, id string) (Item, error)
+	GetCalled int32
+	// Put creates or replaces an item.
+	PutStub   func(ctx context.Context, item Item) error
+	PutCalled int32
+	// Delete removes the item with the given ID.
+	DeleteStub   func(ctx context.Context, id string) error
+	DeleteCalled int32
+	// List returns all of the items in the store.
+	ListStub   func(ctx context.Context) ([]Item, error)
+	ListCalled int32
+
+	mu                 sync.Mutex
+	callsGet           []StoreMockGetArgs
+	expectationsGet    []*StoreMockGetExpectation
+	callsPut           []StoreMockPutArgs
+	expectationsPut    []*StoreMockPutExpectation
+	callsDelete        []StoreMockDeleteArgs
+	expectationsDelete []*StoreMockDeleteExpectation
+	callsList          []StoreMockListArgs
+	expectationsList   []*StoreMockListExpectation
+}
+
+// Verify that *StoreMock implements Store.
+var _ Store = &StoreMock{}
+
+// Get is a stub for the Store.Get
+// method that records the number of times it has been called.
+//
+// Get returns the item with the given ID.
+func (m *StoreMock) Get(ctx context.Context, id string) (Item, error) {
+	atomic.AddInt32(&m.GetCalled, 1)
+	if exp := m.recordGet(StoreMockGetArgs{Ctx: ctx, Id: id}); exp != nil {
+		return exp.results.Result1, exp.results.Result2
+	}
+	if m.GetStub == nil {
+		if m.T != nil {
+			m.T.Error("GetStub is nil")
+		}
+		panic("Get unimplemented")
+	}
+	return m.GetStub(ctx, id)
+}
+
+// StoreMockGetArgs holds the arguments
+// of a call to StoreMock.Get.
+type StoreMockGetArgs struct {
+	Ctx context.Context
+	Id  string
+}
+
+func (args StoreMockGetArgs) call() match.Call {
+	return match.Call{args.Ctx, args.Id}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// StoreMockGetArgs, rather than of the mock, so that the params of Get
+// can't shadow the match package or the params' types.
+func (StoreMockGetArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[context.Context](vs[0]), match.OfType[string](vs[1])}
+}
+
+// GetCalls returns the arguments of each call
+// made to Get so far.
+func (m *StoreMock) GetCalls() []StoreMockGetArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockGetArgs(nil), m.callsGet...)
+}
+
+// StoreMockGetExpectation is an expected call
+// to StoreMock.Get, registered with OnGet.
+type StoreMockGetExpectation struct {
+	matchers []match.Matcher
+	results  StoreMockGetResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *StoreMockGetExpectation) Return(result1 Item, result2 error) {
+	exp.results = StoreMockGetResults{Result1: result1, Result2: result2}
+}
+
+func (exp *StoreMockGetExpectation) matches(args StoreMockGetArgs) bool {
+	return exp.matchers[0].Matches(args.Ctx) &&
+		exp.matchers[1].Matches(args.Id)
+}
+
+// OnGet registers an expected call to Get, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling GetStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless GetStub is set.
+func (m *StoreMock) OnGet(ctx, id any) *StoreMockGetExpectation {
+	return m.expectGet(&StoreMockGetExpectation{
+		matchers: StoreMockGetArgs{}.matchers(ctx, id),
+	})
+}
+
+func (m *StoreMock) expectGet(exp *StoreMockGetExpectation) *StoreMockGetExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsGet = append(m.expectationsGet, exp)
+	return exp
+}
+
+func (m *StoreMock) recordGet(args StoreMockGetArgs) *StoreMockGetExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsGet = append(m.callsGet, args)
+	for _, exp := range m.expectationsGet {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsGet) > 0 && m.GetStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsGet {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("Get", []string{"ctx", "id"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertGetCalledWith fails the test unless Get has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *StoreMock) AssertGetCalledWith(ctx, id any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithGet(&StoreMockGetExpectation{
+		matchers: StoreMockGetArgs{}.matchers(ctx, id),
+	})
+}
+
+func (m *StoreMock) assertCalledWithGet(exp *StoreMockGetExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.GetCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("Get", []string{"ctx", "id"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StoreMockGetResults holds the results
+// of a call to StoreMock.Get.
+type StoreMockGetResults struct {
+	Result1 Item
+	Result2 error
+}
+
+// GetReturnsSequence sets GetStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *StoreMock) GetReturnsSequence(policy sequence.Policy, results ...StoreMockGetResults) {
+	var calls int32
+	m.GetStub = func(context.Context, string) (Item, error) {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("Get called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1, results[i].Result2
+	}
+}
+
+// GetBlocksUntilCanceled sets GetStub to block until the
+// call's context is done, and then return the context's error (along with
+// zero values for any other results).
+func (m *StoreMock) GetBlocksUntilCanceled() {
+	m.GetStub = func(ctx context.Context, id string) (result1 Item, result2 error) {
+		<-ctx.Done()
+		result2 = ctx.Err()
+		return result1, result2
+	}
+}
+
+// GetDelay wraps GetStub, so that calls wait for the given
+// duration before calling it (or returning zero values, if it is nil).
+// If the call's context is done first, the call returns the context's
+// error (along with zero values for any other results) without
+// calling the stub.
+func (m *StoreMock) GetDelay(delay time.Duration) {
+	stub := m.GetStub
+	m.GetStub = func(ctx context.Context, id string) (result1 Item, result2 error) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			result2 = ctx.Err()
+			return result1, result2
+		case <-timer.C:
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx, id)
+	}
+}
+
+// FailGetWith wraps GetStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *StoreMock) FailGetWith(err error, rate float64) {
+	stub := m.GetStub
+	m.GetStub = func(ctx context.Context, id string) (result1 Item, result2 error) {
+		if rand.Float64() < rate {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx, id)
+	}
+}
+
+// FailGetOnCall wraps GetStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *StoreMock) FailGetOnCall(n int, err error) {
+	stub := m.GetStub
+	var calls int32
+	m.GetStub = func(ctx context.Context, id string) (result1 Item, result2 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx, id)
+	}
+}
+
+// Put is a stub for the Store.Put
+// method that records the number of times it has been called.
+//
+// Put creates or replaces an item.
+func (m *StoreMock) Put(ctx context.Context, item Item) error {
+	atomic.AddInt32(&m.PutCalled, 1)
+	if exp := m.recordPut(StoreMockPutArgs{Ctx: ctx, Item: item}); exp != nil {
+		return exp.results.Result1
+	}
+	if m.PutStub == nil {
+		if m.T != nil {
+			m.T.Error("PutStub is nil")
+		}
+		panic("Put unimplemented")
+	}
+	return m.PutStub(ctx, item)
+}
+
+// StoreMockPutArgs holds the arguments
+// of a call to StoreMock.Put.
+type StoreMockPutArgs struct {
+	Ctx  context.Context
+	Item Item
+}
+
+func (args StoreMockPutArgs) call() match.Call {
+	return match.Call{args.Ctx, args.Item}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// StoreMockPutArgs, rather than of the mock, so that the params of Put
+// can't shadow the match package or the params' types.
+func (StoreMockPutArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[context.Context](vs[0]), match.OfType[Item](vs[1])}
+}
+
+// PutCalls returns the arguments of each call
+// made to Put so far.
+func (m *StoreMock) PutCalls() []StoreMockPutArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockPutArgs(nil), m.callsPut...)
+}
+
+// StoreMockPutExpectation is an expected call
+// to StoreMock.Put, registered with OnPut.
+type StoreMockPutExpectation struct {
+	matchers []match.Matcher
+	results  StoreMockPutResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *StoreMockPutExpectation) Return(result1 error) {
+	exp.results = StoreMockPutResults{Result1: result1}
+}
+
+func (exp *StoreMockPutExpectation) matches(args StoreMockPutArgs) bool {
+	return exp.matchers[0].Matches(args.Ctx) &&
+		exp.matchers[1].Matches(args.Item)
+}
+
+// OnPut registers an expected call to Put, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling PutStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless PutStub is set.
+func (m *StoreMock) OnPut(ctx, item any) *StoreMockPutExpectation {
+	return m.expectPut(&StoreMockPutExpectation{
+		matchers: StoreMockPutArgs{}.matchers(ctx, item),
+	})
+}
+
+func (m *StoreMock) expectPut(exp *StoreMockPutExpectation) *StoreMockPutExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsPut = append(m.expectationsPut, exp)
+	return exp
+}
+
+func (m *StoreMock) recordPut(args StoreMockPutArgs) *StoreMockPutExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsPut = append(m.callsPut, args)
+	for _, exp := range m.expectationsPut {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsPut) > 0 && m.PutStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsPut {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("Put", []string{"ctx", "item"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertPutCalledWith fails the test unless Put has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *StoreMock) AssertPutCalledWith(ctx, item any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithPut(&StoreMockPutExpectation{
+		matchers: StoreMockPutArgs{}.matchers(ctx, item),
+	})
+}
+
+func (m *StoreMock) assertCalledWithPut(exp *StoreMockPutExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.PutCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("Put", []string{"ctx", "item"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StoreMockPutResults holds the results
+// of a call to StoreMock.Put.
+type StoreMockPutResults struct {
+	Result1 error
+}
+
+// PutReturnsSequence sets PutStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *StoreMock) PutReturnsSequence(policy sequence.Policy, results ...StoreMockPutResults) {
+	var calls int32
+	m.PutStub = func(context.Context, Item) error {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("Put called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
+// PutBlocksUntilCanceled sets PutStub to block until the
+// call's context is done, and then return the context's error (along with
+// zero values for any other results).
+func (m *StoreMock) PutBlocksUntilCanceled() {
+	m.PutStub = func(ctx context.Context, item Item) (result1 error) {
+		<-ctx.Done()
+		result1 = ctx.Err()
+		return result1
+	}
+}
+
+// PutDelay wraps PutStub, so that calls wait for the given
+// duration before calling it (or returning zero values, if it is nil).
+// If the call's context is done first, the call returns the context's
+// error (along with zero values for any other results) without
+// calling the stub.
+func (m *StoreMock) PutDelay(delay time.Duration) {
+	stub := m.PutStub
+	m.PutStub = func(ctx context.Context, item Item) (result1 error) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			result1 = ctx.Err()
+			return result1
+		case <-timer.C:
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, item)
+	}
+}
+
+// FailPutWith wraps PutStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *StoreMock) FailPutWith(err error, rate float64) {
+	stub := m.PutStub
+	m.PutStub = func(ctx context.Context, item Item) (result1 error) {
+		if rand.Float64() < rate {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, item)
+	}
+}
+
+// FailPutOnCall wraps PutStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *StoreMock) FailPutOnCall(n int, err error) {
+	stub := m.PutStub
+	var calls int32
+	m.PutStub = func(ctx context.Context, item Item) (result1 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, item)
+	}
+}
+
+// Delete is a stub for the Store.Delete
+// method that records the number of times it has been called.
+//
+// Delete removes the item with the given ID.
+func (m *StoreMock) Delete(ctx context.Context, id string) error {
+	atomic.AddInt32(&m.DeleteCalled, 1)
+	if exp := m.recordDelete(StoreMockDeleteArgs{Ctx: ctx, Id: id}); exp != nil {
+		return exp.results.Result1
+	}
+	if m.DeleteStub == nil {
+		if m.T != nil {
+			m.T.Error("DeleteStub is nil")
+		}
+		panic("Delete unimplemented")
+	}
+	return m.DeleteStub(ctx, id)
+}
+
+// StoreMockDeleteArgs holds the arguments
+// of a call to StoreMock.Delete.
+type StoreMockDeleteArgs struct {
+	Ctx context.Context
+	Id  string
+}
+
+func (args StoreMockDeleteArgs) call() match.Call {
+	return match.Call{args.Ctx, args.Id}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// StoreMockDeleteArgs, rather than of the mock, so that the params of Delete
+// can't shadow the match package or the params' types.
+func (StoreMockDeleteArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[context.Context](vs[0]), match.OfType[string](vs[1])}
+}
+
+// DeleteCalls returns the arguments of each call
+// made to Delete so far.
+func (m *StoreMock) DeleteCalls() []StoreMockDeleteArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockDeleteArgs(nil), m.callsDelete...)
+}
+
+// StoreMockDeleteExpectation is an expected call
+// to StoreMock.Delete, registered with OnDelete.
+type StoreMockDeleteExpectation struct {
+	matchers []match.Matcher
+	results  StoreMockDeleteResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *StoreMockDeleteExpectation) Return(result1 error) {
+	exp.results = StoreMockDeleteResults{Result1: result1}
+}
+
+func (exp *StoreMockDeleteExpectation) matches(args StoreMockDeleteArgs) bool {
+	return exp.matchers[0].Matches(args.Ctx) &&
+		exp.matchers[1].Matches(args.Id)
+}
+
+// OnDelete registers an expected call to Delete, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling DeleteStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless DeleteStub is set.
+func (m *StoreMock) OnDelete(ctx, id any) *StoreMockDeleteExpectation {
+	return m.expectDelete(&StoreMockDeleteExpectation{
+		matchers: StoreMockDeleteArgs{}.matchers(ctx, id),
+	})
+}
+
+func (m *StoreMock) expectDelete(exp *StoreMockDeleteExpectation) *StoreMockDeleteExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsDelete = append(m.expectationsDelete, exp)
+	return exp
+}
+
+func (m *StoreMock) recordDelete(args StoreMockDeleteArgs) *StoreMockDeleteExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsDelete = append(m.callsDelete, args)
+	for _, exp := range m.expectationsDelete {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsDelete) > 0 && m.DeleteStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsDelete {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("Delete", []string{"ctx", "id"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertDeleteCalledWith fails the test unless Delete has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *StoreMock) AssertDeleteCalledWith(ctx, id any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithDelete(&StoreMockDeleteExpectation{
+		matchers: StoreMockDeleteArgs{}.matchers(ctx, id),
+	})
+}
+
+func (m *StoreMock) assertCalledWithDelete(exp *StoreMockDeleteExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.DeleteCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("Delete", []string{"ctx", "id"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StoreMockDeleteResults holds the results
+// of a call to StoreMock.Delete.
+type StoreMockDeleteResults struct {
+	Result1 error
+}
+
+// DeleteReturnsSequence sets DeleteStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *StoreMock) DeleteReturnsSequence(policy sequence.Policy, results ...StoreMockDeleteResults) {
+	var calls int32
+	m.DeleteStub = func(context.Context, string) error {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("Delete called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1
+	}
+}
+
+// DeleteBlocksUntilCanceled sets DeleteStub to block until the
+// call's context is done, and then return the context's error (along with
+// zero values for any other results).
+func (m *StoreMock) DeleteBlocksUntilCanceled() {
+	m.DeleteStub = func(ctx context.Context, id string) (result1 error) {
+		<-ctx.Done()
+		result1 = ctx.Err()
+		return result1
+	}
+}
+
+// DeleteDelay wraps DeleteStub, so that calls wait for the given
+// duration before calling it (or returning zero values, if it is nil).
+// If the call's context is done first, the call returns the context's
+// error (along with zero values for any other results) without
+// calling the stub.
+func (m *StoreMock) DeleteDelay(delay time.Duration) {
+	stub := m.DeleteStub
+	m.DeleteStub = func(ctx context.Context, id string) (result1 error) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			result1 = ctx.Err()
+			return result1
+		case <-timer.C:
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, id)
+	}
+}
+
+// FailDeleteWith wraps DeleteStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *StoreMock) FailDeleteWith(err error, rate float64) {
+	stub := m.DeleteStub
+	m.DeleteStub = func(ctx context.Context, id string) (result1 error) {
+		if rand.Float64() < rate {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, id)
+	}
+}
+
+// FailDeleteOnCall wraps DeleteStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *StoreMock) FailDeleteOnCall(n int, err error) {
+	stub := m.DeleteStub
+	var calls int32
+	m.DeleteStub = func(ctx context.Context, id string) (result1 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result1 = err
+			return result1
+		}
+		if stub == nil {
+			return result1
+		}
+		return stub(ctx, id)
+	}
+}
+
+// List is a stub for the Store.List
+// method that records the number of times it has been called.
+//
+// List returns all of the items in the store.
+func (m *StoreMock) List(ctx context.Context) ([]Item, error) {
+	atomic.AddInt32(&m.ListCalled, 1)
+	if exp := m.recordList(StoreMockListArgs{Ctx: ctx}); exp != nil {
+		return exp.results.Result1, exp.results.Result2
+	}
+	if m.ListStub == nil {
+		if m.T != nil {
+			m.T.Error("ListStub is nil")
+		}
+		panic("List unimplemented")
+	}
+	return m.ListStub(ctx)
+}
+
+// StoreMockListArgs holds the arguments
+// of a call to StoreMock.List.
+type StoreMockListArgs struct {
+	Ctx context.Context
+}
+
+func (args StoreMockListArgs) call() match.Call {
+	return match.Call{args.Ctx}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// StoreMockListArgs, rather than of the mock, so that the params of List
+// can't shadow the match package or the params' types.
+func (StoreMockListArgs) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{match.OfType[context.Context](vs[0])}
+}
+
+// ListCalls returns the arguments of each call
+// made to List so far.
+func (m *StoreMock) ListCalls() []StoreMockListArgs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockListArgs(nil), m.callsList...)
+}
+
+// StoreMockListExpectation is an expected call
+// to StoreMock.List, registered with OnList.
+type StoreMockListExpectation struct {
+	matchers []match.Matcher
+	results  StoreMockListResults
+	calls    int
+}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func (exp *StoreMockListExpectation) Return(result1 []Item, result2 error) {
+	exp.results = StoreMockListResults{Result1: result1, Result2: result2}
+}
+
+func (exp *StoreMockListExpectation) matches(args StoreMockListArgs) bool {
+	return exp.matchers[0].Matches(args.Ctx)
+}
+
+// OnList registers an expected call to List, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling ListStub. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless ListStub is set.
+func (m *StoreMock) OnList(ctx any) *StoreMockListExpectation {
+	return m.expectList(&StoreMockListExpectation{
+		matchers: StoreMockListArgs{}.matchers(ctx),
+	})
+}
+
+func (m *StoreMock) expectList(exp *StoreMockListExpectation) *StoreMockListExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectationsList = append(m.expectationsList, exp)
+	return exp
+}
+
+func (m *StoreMock) recordList(args StoreMockListArgs) *StoreMockListExpectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callsList = append(m.callsList, args)
+	for _, exp := range m.expectationsList {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectationsList) > 0 && m.ListStub == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectationsList {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("List", []string{"ctx"}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// AssertListCalledWith fails the test unless List has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func (m *StoreMock) AssertListCalledWith(ctx any) bool {
+	if m.T != nil {
+		m.T.Helper()
+	}
+	return m.assertCalledWithList(&StoreMockListExpectation{
+		matchers: StoreMockListArgs{}.matchers(ctx),
+	})
+}
+
+func (m *StoreMock) assertCalledWithList(exp *StoreMockListExpectation) bool {
+	var calls []match.Call
+	for _, args := range m.ListCalls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("List", []string{"ctx"}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+
+// StoreMockListResults holds the results
+// of a call to StoreMock.List.
+type StoreMockListResults struct {
+	Result1 []Item
+	Result2 error
+}
+
+// ListReturnsSequence sets ListStub to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *StoreMock) ListReturnsSequence(policy sequence.Policy, results ...StoreMockListResults) {
+	var calls int32
+	m.ListStub = func(context.Context) ([]Item, error) {
+		n := atomic.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("List called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return results[i].Result1, results[i].Result2
+	}
+}
+
+// ListBlocksUntilCanceled sets ListStub to block until the
+// call's context is done, and then return the context's error (along with
+// zero values for any other results).
+func (m *StoreMock) ListBlocksUntilCanceled() {
+	m.ListStub = func(ctx context.Context) (result1 []Item, result2 error) {
+		<-ctx.Done()
+		result2 = ctx.Err()
+		return result1, result2
+	}
+}
+
+// ListDelay wraps ListStub, so that calls wait for the given
+// duration before calling it (or returning zero values, if it is nil).
+// If the call's context is done first, the call returns the context's
+// error (along with zero values for any other results) without
+// calling the stub.
+func (m *StoreMock) ListDelay(delay time.Duration) {
+	stub := m.ListStub
+	m.ListStub = func(ctx context.Context) (result1 []Item, result2 error) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			result2 = ctx.Err()
+			return result1, result2
+		case <-timer.C:
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx)
+	}
+}
+
+// FailListWith wraps ListStub, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *StoreMock) FailListWith(err error, rate float64) {
+	stub := m.ListStub
+	m.ListStub = func(ctx context.Context) (result1 []Item, result2 error) {
+		if rand.Float64() < rate {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx)
+	}
+}
+
+// FailListOnCall wraps ListStub, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *StoreMock) FailListOnCall(n int, err error) {
+	stub := m.ListStub
+	var calls int32
+	m.ListStub = func(ctx context.Context) (result1 []Item, result2 error) {
+		if int(atomic.AddInt32(&calls, 1)) == n {
+			result2 = err
+			return result1, result2
+		}
+		if stub == nil {
+			return result1, result2
+		}
+		return stub(ctx)
+	}
+}
+
+// StubUsage returns the usage of each of the stubs that are set, and each
+// of the registered expectations, so that the ones that are never called
+// can be reported (see usage.Check).
+func (m *StoreMock) StubUsage() []usage.Stub {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var stubs []usage.Stub
+
+	// Calls that don't match an expectation are made to the stub
+	{
+		calls := int(atomic.LoadInt32(&m.GetCalled))
+		for _, exp := range m.expectationsGet {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "StoreMock",
+				Method:      "Get",
+				Expectation: match.Describe("Get", []string{"ctx", "id"}, exp.matchers),
+				OnMethod:    "OnGet",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.GetStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "StoreMock", Method: "Get", Field: "GetStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.PutCalled))
+		for _, exp := range m.expectationsPut {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "StoreMock",
+				Method:      "Put",
+				Expectation: match.Describe("Put", []string{"ctx", "item"}, exp.matchers),
+				OnMethod:    "OnPut",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.PutStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "StoreMock", Method: "Put", Field: "PutStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.DeleteCalled))
+		for _, exp := range m.expectationsDelete {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "StoreMock",
+				Method:      "Delete",
+				Expectation: match.Describe("Delete", []string{"ctx", "id"}, exp.matchers),
+				OnMethod:    "OnDelete",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.DeleteStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "StoreMock", Method: "Delete", Field: "DeleteStub", Calls: calls})
+		}
+	}
+	{
+		calls := int(atomic.LoadInt32(&m.ListCalled))
+		for _, exp := range m.expectationsList {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "StoreMock",
+				Method:      "List",
+				Expectation: match.Describe("List", []string{"ctx"}, exp.matchers),
+				OnMethod:    "OnList",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.ListStub != nil {
+			stubs = append(stubs, usage.Stub{Mock: "StoreMock", Method: "List", Field: "ListStub", Calls: calls})
+		}
+	}
+	return stubs
+}
+
+// FailAll wraps the stubs of all of the methods whose last result is an
+// error, so that every call to them fails with the given error (and
+// zero values for any other results).
+func (m *StoreMock) FailAll(err error) {
+	m.FailGetWith(err, 1)
+	m.FailPutWith(err, 1)
+	m.FailDeleteWith(err, 1)
+	m.FailListWith(err, 1)
+}
@@ -0,0 +1,251 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 988bca65495d8cb0
+
+package example
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+)
+
+// StoreRecordedCall is a call made through a StoreRecorder,
+// as stored in a golden file. Context arguments are not recorded, and
+// errors are recorded as their messages.
+type StoreRecordedCall struct {
+	Method  string            `json:"method"`
+	Args    json.RawMessage   `json:"args"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// StoreRecorder is a decorator for the Store interface that
+// records the arguments and results of each call made to the underlying
+// implementation, so that they can be saved to a golden file and served
+// back by a StoreReplayer.
+type StoreRecorder struct {
+	Next Store
+
+	mu    sync.Mutex
+	calls []StoreRecordedCall
+	err   error
+}
+
+// NewStoreRecorder returns a StoreRecorder that records
+// the calls made to next.
+func NewStoreRecorder(next Store) *StoreRecorder {
+	return &StoreRecorder{Next: next}
+}
+
+// Verify that *StoreRecorder implements Store.
+var _ Store = &StoreRecorder{}
+
+// RecordedCalls returns the calls recorded so far.
+func (rec *StoreRecorder) RecordedCalls() []StoreRecordedCall {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]StoreRecordedCall(nil), rec.calls...)
+}
+
+// SaveGolden writes the calls recorded so far to a golden file at path.
+// It returns an error if any of the calls' arguments or results could
+// not be encoded as JSON.
+func (rec *StoreRecorder) SaveGolden(path string) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.err != nil {
+		return rec.err
+	}
+	data, err := json.MarshalIndent(rec.calls, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func (rec *StoreRecorder) record(method string, args []any, results []any) {
+	call := StoreRecordedCall{Method: method}
+	data, err := json.Marshal(args)
+	if err == nil {
+		call.Args = data
+		for _, result := range results {
+			data, err = json.Marshal(result)
+			if err != nil {
+				break
+			}
+			call.Results = append(call.Results, data)
+		}
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if err != nil && rec.err == nil {
+		rec.err = fmt.Errorf("error recording call to Store.%s: %w", method, err)
+	}
+	rec.calls = append(rec.calls, call)
+}
+
+// Get delegates the call to the underlying Store,
+// and records its arguments and results.
+func (rec *StoreRecorder) Get(ctx context.Context, id string) (result1 Item, result2 error) {
+	result1, result2 = rec.Next.Get(ctx, id)
+	rec.record("Get", []any{id}, []any{result1, errorMessageStore(result2)})
+	return result1, result2
+}
+
+// Put delegates the call to the underlying Store,
+// and records its arguments and results.
+func (rec *StoreRecorder) Put(ctx context.Context, item Item) (result1 error) {
+	result1 = rec.Next.Put(ctx, item)
+	rec.record("Put", []any{item}, []any{errorMessageStore(result1)})
+	return result1
+}
+
+// Delete delegates the call to the underlying Store,
+// and records its arguments and results.
+func (rec *StoreRecorder) Delete(ctx context.Context, id string) (result1 error) {
+	result1 = rec.Next.Delete(ctx, id)
+	rec.record("Delete", []any{id}, []any{errorMessageStore(result1)})
+	return result1
+}
+
+// List delegates the call to the underlying Store,
+// and records its arguments and results.
+func (rec *StoreRecorder) List(ctx context.Context) (result1 []Item, result2 error) {
+	result1, result2 = rec.Next.List(ctx)
+	rec.record("List", []any{}, []any{result1, errorMessageStore(result2)})
+	return result1, result2
+}
+
+// StoreReplayer serves the calls recorded by a StoreRecorder
+// back to a StoreMock. Each call to the mock is matched to the first
+// unused recorded call with the same method name and arguments. If all of
+// the matching recorded calls have already been used, the last one is
+// used again.
+type StoreReplayer struct {
+	T *testing.T
+
+	mu    sync.Mutex
+	calls []StoreRecordedCall
+	used  []bool
+}
+
+// LoadStoreReplayer loads the calls recorded in the golden file
+// at path, failing the test if it can't be read.
+func LoadStoreReplayer(t *testing.T, path string) *StoreReplayer {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading Store golden file: %s", err)
+	}
+	var calls []StoreRecordedCall
+	if err := json.Unmarshal(data, &calls); err != nil {
+		t.Fatalf("error decoding Store golden file %s: %s", path, err)
+	}
+
+	// Undo any indentation, so that the recorded arguments
+	// can be compared to the encoded arguments of each call
+	for i, call := range calls {
+		var args bytes.Buffer
+		if err := json.Compact(&args, call.Args); err != nil {
+			t.Fatalf("error decoding Store golden file %s: %s", path, err)
+		}
+		calls[i].Args = args.Bytes()
+	}
+	return &StoreReplayer{T: t, calls: calls, used: make([]bool, len(calls))}
+}
+
+// Mock returns a StoreMock whose stubs serve the recorded calls.
+func (rep *StoreReplayer) Mock() *StoreMock {
+	m := &StoreMock{T: rep.T}
+	m.GetStub = func(ctx context.Context, id string) (result1 Item, result2 error) {
+		results := rep.replay("Get", 2, []any{id})
+		rep.decode("Get", results[0], &result1)
+		result2 = rep.decodeError("Get", results[1])
+		return result1, result2
+	}
+	m.PutStub = func(ctx context.Context, item Item) (result1 error) {
+		results := rep.replay("Put", 1, []any{item})
+		result1 = rep.decodeError("Put", results[0])
+		return result1
+	}
+	m.DeleteStub = func(ctx context.Context, id string) (result1 error) {
+		results := rep.replay("Delete", 1, []any{id})
+		result1 = rep.decodeError("Delete", results[0])
+		return result1
+	}
+	m.ListStub = func(ctx context.Context) (result1 []Item, result2 error) {
+		results := rep.replay("List", 2, []any{})
+		rep.decode("List", results[0], &result1)
+		result2 = rep.decodeError("List", results[1])
+		return result1, result2
+	}
+	return m
+}
+
+func (rep *StoreReplayer) replay(method string, numResults int, args []any) []json.RawMessage {
+	data, err := json.Marshal(args)
+	if err != nil {
+		rep.fail("error encoding arguments to Store.%s: %s", method, err)
+	}
+
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	match := -1
+	for i, call := range rep.calls {
+		if call.Method != method || !bytes.Equal(call.Args, data) {
+			continue
+		}
+		match = i
+		if !rep.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		rep.fail("no recorded call to Store.%s with arguments %s", method, data)
+	}
+	rep.used[match] = true
+
+	results := rep.calls[match].Results
+	if len(results) != numResults {
+		rep.fail("recorded call to Store.%s has %d results, expected %d", method, len(results), numResults)
+	}
+	return results
+}
+
+func (rep *StoreReplayer) decode(method string, data json.RawMessage, v any) {
+	if err := json.Unmarshal(data, v); err != nil {
+		rep.fail("error decoding recorded result of Store.%s: %s", method, err)
+	}
+}
+
+func (rep *StoreReplayer) decodeError(method string, data json.RawMessage) error {
+	var msg *string
+	rep.decode(method, data, &msg)
+	if msg == nil {
+		return nil
+	}
+	return errors.New(*msg)
+}
+
+func (rep *StoreReplayer) fail(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if rep.T != nil {
+		rep.T.Error(msg)
+	}
+	panic(msg)
+}
+
+// errorMessageStore returns the message of a recorded error,
+// or nil if there was no error.
+func errorMessageStore(err error) *string {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	return &msg
+}
@@ -0,0 +1,77 @@
+// Code generated by mock. DO NOT EDIT.
+// mock fingerprint: 538accbe824a9332
+
+package example
+
+import (
+	"context"
+)
+
+// StoreTracingTracer starts a span for each call made through a
+// StoreTracing decorator. The returned function ends the span,
+// recording the error returned by the call (if any).
+type StoreTracingTracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, func(err error))
+}
+
+// StoreTracing is a decorator for the Store interface
+// that wraps each method call in a span. For methods that take a
+// context.Context as their first parameter, the span's context is
+// passed on to the underlying implementation.
+type StoreTracing struct {
+	Next   Store
+	Tracer StoreTracingTracer
+}
+
+// NewStoreTracing returns a StoreTracing decorator that
+// traces the calls made to next with tracer.
+func NewStoreTracing(next Store, tracer StoreTracingTracer) *StoreTracing {
+	return &StoreTracing{Next: next, Tracer: tracer}
+}
+
+// Verify that *StoreTracing implements Store.
+var _ Store = &StoreTracing{}
+
+// Get delegates the call to the underlying Store
+// within a "Store.Get" span.
+//
+// Get returns the item with the given ID.
+func (dec *StoreTracing) Get(ctx context.Context, id string) (result1 Item, result2 error) {
+	ctx, endSpan := dec.Tracer.Start(ctx, "Store.Get")
+	result1, result2 = dec.Next.Get(ctx, id)
+	endSpan(result2)
+	return result1, result2
+}
+
+// Put delegates the call to the underlying Store
+// within a "Store.Put" span.
+//
+// Put creates or replaces an item.
+func (dec *StoreTracing) Put(ctx context.Context, item Item) (result1 error) {
+	ctx, endSpan := dec.Tracer.Start(ctx, "Store.Put")
+	result1 = dec.Next.Put(ctx, item)
+	endSpan(result1)
+	return result1
+}
+
+// Delete delegates the call to the underlying Store
+// within a "Store.Delete" span.
+//
+// Delete removes the item with the given ID.
+func (dec *StoreTracing) Delete(ctx context.Context, id string) (result1 error) {
+	ctx, endSpan := dec.Tracer.Start(ctx, "Store.Delete")
+	result1 = dec.Next.Delete(ctx, id)
+	endSpan(result1)
+	return result1
+}
+
+// List delegates the call to the underlying Store
+// within a "Store.List" span.
+//
+// List returns all of the items in the store.
+func (dec *StoreTracing) List(ctx context.Context) (result1 []Item, result2 error) {
+	ctx, endSpan := dec.Tracer.Start(ctx, "Store.List")
+	result1, result2 = dec.Next.List(ctx)
+	endSpan(result2)
+	return result1, result2
+}
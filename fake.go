@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// Kinds of methods recognized when generating a fake.
+const (
+	fakeGet    = "get"
+	fakePut    = "put"
+	fakeDelete = "delete"
+	fakeList   = "list"
+	fakeOther  = ""
+)
+
+// Name prefixes identifying each kind of method.
+var fakePrefixes = map[string][]string{
+	fakeGet:    {"Get", "Find", "Load", "Fetch"},
+	fakePut:    {"Put", "Save", "Store", "Set", "Create", "Update", "Upsert", "Add"},
+	fakeDelete: {"Delete", "Remove"},
+	fakeList:   {"List", "All"},
+}
+
+// fake is the data used to generate a stateful, in-memory fake
+// implementation of a CRUD-shaped interface.
+type fake struct {
+	iface.Interface
+
+	// Types of the keys and values stored by the fake
+	KeyType   string
+	ValueType string
+
+	// Whether any of the methods store values without an explicit
+	// key, in which case the fake needs a function to derive one
+	NeedsKeyFunc bool
+
+	FakeMethods []fakeMethod
+
+	key, value types.Type
+}
+
+// fakeMethod is a method of a fake, along with the role it plays in it.
+type fakeMethod struct {
+	iface.Method
+
+	// Kind of method (see the fakeXxx constants), or
+	// empty if the method isn't recognized
+	Kind string
+
+	// Names of the parameters holding the key and
+	// value (empty if the method doesn't take them)
+	Key   string
+	Value string
+}
+
+// newFake classifies the methods of an interface by their name prefixes and
+// signatures. Each method's parameters, after an optional leading context,
+// must be:
+//
+//	Get(key K) (V, error)
+//	Put(value V) error, or Put(key K, value V) error
+//	Delete(key K) error
+//	List() ([]V, error)
+//
+// All recognized methods must agree on the key and value types, which are
+// determined by the first recognized method that fixes them. Methods that
+// aren't recognized are left to stubs.
+func newFake(i iface.Interface) (any, error) {
+	f := &fake{Interface: i}
+
+	// Determine the key and value types, preferring the most informative
+	// methods (i.e. Get, which fixes both) where possible
+	for _, kind := range []string{fakeGet, fakeList, fakeDelete, fakePut} {
+		for _, method := range i.Methods {
+			// Only keep the types of methods that are fully recognized
+			tentative := *f
+			if _, _, ok := tentative.classify(method, kind); ok {
+				*f = tentative
+			}
+		}
+	}
+
+	for _, method := range i.Methods {
+		m := fakeMethod{Method: method}
+		for _, kind := range []string{fakeGet, fakePut, fakeDelete, fakeList} {
+			if key, value, ok := f.classify(method, kind); ok {
+				m.Kind, m.Key, m.Value = kind, key, value
+				break
+			}
+		}
+		if m.Kind == fakePut && m.Key == "" {
+			f.NeedsKeyFunc = true
+		}
+		f.FakeMethods = append(f.FakeMethods, m)
+	}
+
+	if f.key == nil {
+		// Without a key type, only List and unkeyed Put methods could
+		// have been recognized, and those can't be implemented
+		for i := range f.FakeMethods {
+			f.FakeMethods[i].Kind = fakeOther
+		}
+		f.NeedsKeyFunc = false
+	}
+
+	// The fake is only useful if it at least stores values
+	for _, m := range f.FakeMethods {
+		if m.Kind != fakeOther {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%s has no Get, Put, Delete or List methods with recognized signatures", i.Name)
+}
+
+// classify reports whether the method is of the given kind, returning the
+// names of its key and value parameters (if any). If the fake's key and
+// value types haven't been determined yet, they're taken from the method.
+func (f *fake) classify(method iface.Method, kind string) (key, value string, ok bool) {
+	if !hasAnyPrefix(method.Name, fakePrefixes[kind]) {
+		return "", "", false
+	}
+
+	var (
+		params  = method.Params
+		names   = method.Params.Names()
+		results = method.Results
+	)
+	if method.TakesContext() {
+		params, names = params[1:], names[1:]
+	}
+	if len(results) == 0 || !results[len(results)-1].IsError() {
+		return "", "", false
+	}
+	for _, p := range params {
+		if p.Variadic || p.GoType == nil {
+			return "", "", false
+		}
+	}
+	for _, r := range results {
+		if r.GoType == nil {
+			return "", "", false
+		}
+	}
+
+	switch kind {
+	case fakeGet:
+		if len(params) == 1 && len(results) == 2 &&
+			f.matchKey(params[0]) && f.matchValue(results[0].GoType, results[0].Type) {
+			return names[0], "", true
+		}
+
+	case fakePut:
+		if len(results) != 1 {
+			return "", "", false
+		}
+		switch len(params) {
+		case 1:
+			if f.matchValue(params[0].GoType, params[0].Type) {
+				return "", names[0], true
+			}
+		case 2:
+			if f.matchKey(params[0]) && f.matchValue(params[1].GoType, params[1].Type) {
+				return names[0], names[1], true
+			}
+		}
+
+	case fakeDelete:
+		if len(params) == 1 && len(results) == 1 && f.matchKey(params[0]) {
+			return names[0], "", true
+		}
+
+	case fakeList:
+		if len(params) != 0 || len(results) != 2 {
+			return "", "", false
+		}
+		slice, isSlice := results[0].GoType.(*types.Slice)
+		if isSlice && f.matchValue(slice.Elem(), strings.TrimPrefix(results[0].Type, "[]")) {
+			return "", "", true
+		}
+	}
+	return "", "", false
+}
+
+// matchKey reports whether the param's type is the fake's key type,
+// which it becomes if the key type hasn't been determined yet.
+func (f *fake) matchKey(param iface.Param) bool {
+	if f.key == nil {
+		// Keys must be usable as map keys
+		if !types.Comparable(param.GoType) {
+			return false
+		}
+		f.key, f.KeyType = param.GoType, param.Type
+	}
+	return types.Identical(f.key, param.GoType)
+}
+
+// matchValue reports whether the type is the fake's value type,
+// which it becomes if the value type hasn't been determined yet.
+func (f *fake) matchValue(typ types.Type, typeString string) bool {
+	if f.value == nil {
+		f.value, f.ValueType = typ, typeString
+	}
+	return types.Identical(f.value, typ)
+}
+
+// hasAnyPrefix reports whether the name begins with any of the prefixes,
+// followed by the end of the name or the next (capitalized) word.
+func hasAnyPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(rest); rest == "" || unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// generatedHeader marks the output as generated code (see
+// https://go.dev/s/generatedcode), and fingerprintPrefix starts the
+// line after it, which records the output's fingerprint.
+const (
+	generatedHeader   = "// Code generated by mock. DO NOT EDIT.\n"
+	fingerprintPrefix = "// mock fingerprint: "
+)
+
+// fingerprint returns a fingerprint of everything the implementation of the
+// interface in the given style depends on: the interface's signature (i.e.
+// everything about it other than its position), the style's template, the
+// naming, the version of mock, and the directory of the output file (which
+// determines the package it belongs to), relative to the interface's.
+func fingerprint(i iface.Interface, styleName string, naming naming, outFile string) string {
+	h := sha256.New()
+	style := styles[styleName]
+	fmt.Fprintf(h, "%s\n%s\n%s\n", toolVersion(), styleName, style.tmpl)
+	fmt.Fprintf(h, "%s %s %s\n", naming.typeName(style, i.Name), naming.stub(""), naming.called(""))
+	if outFile != "" {
+		fmt.Fprintf(h, "%s\n", filepath.ToSlash(relPath(filepath.Dir(i.Position.Filename), filepath.Dir(outFile))))
+	}
+	fmt.Fprintf(h, "%s %s%s\n%q\n", i.PkgPath, i.Name, i.TypeParams, i.Doc)
+	fmt.Fprintf(h, "%v %v %v\n", i.Imports, i.Test, i.SyntaxOnly)
+	for _, m := range i.Methods {
+		fmt.Fprintf(h, "%s(%s) (%s) %s %q\n", m.Name, m.Params, m.Results, m.Embedded, m.Doc)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// toolVersion returns the version of mock's module, along
+// with the VCS revision it was built from, if known.
+func toolVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	version := info.Main.Version
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision", "vcs.modified":
+			version += " " + setting.Value
+		}
+	}
+	return version
+}
+
+// header returns the header of generated output with the fingerprint.
+func header(fingerprint string) string {
+	return generatedHeader + fingerprintPrefix + fingerprint + "\n\n"
+}
+
+// readFingerprint returns the fingerprint recorded in the header of the
+// file, or "" if it doesn't exist or wasn't generated by mock.
+func readFingerprint(file string) string {
+	f, err := os.Open(file)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	// Only the header needs to be read
+	scanner := bufio.NewScanner(io.LimitReader(f, int64(len(generatedHeader)+len(fingerprintPrefix)+64)))
+	if !scanner.Scan() || scanner.Text()+"\n" != generatedHeader || !scanner.Scan() {
+		return ""
+	}
+	fingerprint, ok := strings.CutPrefix(scanner.Text(), fingerprintPrefix)
+	if !ok {
+		return ""
+	}
+	return fingerprint
+}
+
+// upToDate reports whether the file's header records the fingerprint of
+// the interface's implementation in the given style, in which case
+// regenerating it would produce the same output (unless it's been edited).
+func upToDate(file string, i iface.Interface, styleName string, naming naming) bool {
+	fp := readFingerprint(file)
+	return fp != "" && fp == fingerprint(i, styleName, naming, file)
+}
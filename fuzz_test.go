@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// FuzzGenerate synthesizes a package of random interfaces from the seed,
+// generates every style of implementation of each of them, and checks that
+// the output compiles. Since each implementation verifies that it implements
+// its interface, that's also checked.
+func FuzzGenerate(f *testing.F) {
+	for seed := range int64(16) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		// The package must be inside the module to be able to import the
+		// packages used by the generated code, and is written to testdata
+		// so that it's ignored by the go command's ./... pattern
+		dir, err := os.MkdirTemp("testdata", "synth")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { os.RemoveAll(dir) })
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := newSynth(seed)
+		src := s.pkg(filepath.Base(dir))
+		if err := os.WriteFile(filepath.Join(dir, "synth.go"), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		loader, err := iface.NewLoader(iface.Config{Dir: dir}, ".")
+		if err != nil {
+			t.Fatalf("error loading package: %s\n%s", err, src)
+		}
+		var ifaces []iface.Interface
+		for _, name := range s.ifaces {
+			i, err := loader.Interface(name)
+			if err != nil {
+				t.Fatalf("error getting interface %s: %s\n%s", name, err, src)
+			}
+			ifaces = append(ifaces, i)
+		}
+
+		styleNames := sortedStyleNames()
+		outputs := map[string]map[string][]byte{}
+		for _, styleName := range styleNames {
+			outputs[styleName] = map[string][]byte{}
+			for _, i := range ifaces {
+				outFile := filepath.Join(absDir, fmt.Sprintf("%s_%s.go", strings.ToLower(i.Name), styleName))
+				output, err := generate(i, styleName, naming{}, outFile)
+				if err != nil {
+					// Only CRUD-shaped interfaces can be faked
+					if styleName == "fake" {
+						continue
+					}
+					t.Fatalf("error generating %s %s: %s\n%s", i.Name, styleName, err, src)
+				}
+				outputs[styleName][outFile] = checkedOutput(styleName, output)
+			}
+		}
+
+		for _, group := range checkGroups(styleNames, outputs) {
+			typeCheck(t, dir, group.files)
+		}
+		if t.Failed() {
+			t.Logf("synthesized package:\n%s", src)
+		}
+	})
+}
+
+// synth synthesizes random Go source code.
+type synth struct {
+	rand *rand.Rand
+
+	// Names of the synthesized interfaces, and the
+	// imports used by the synthesized source so far
+	ifaces  []string
+	imports map[string]bool
+
+	// Type parameters of the interface being synthesized
+	typeParams []string
+}
+
+func newSynth(seed int64) *synth {
+	return &synth{
+		rand:    rand.New(rand.NewPCG(uint64(seed), 0)),
+		imports: map[string]bool{},
+	}
+}
+
+// synthImports are the imports that synthesized types can refer to, along
+// with a type from each one, as it's referred to with that import.
+var synthImports = map[string]string{
+	`"context"`:       "context.Context",
+	`"io"`:            "io.Reader",
+	`"time"`:          "time.Duration",
+	`. "net/url"`:     "URL",
+	`"encoding/json"`: "json.RawMessage",
+	`"sync/atomic"`:   "*atomic.Int64",
+	`"log/slog"`:      "slog.Level",
+	`"math/rand/v2"`:  "*rand.Rand",
+	`"reflect"`:       "reflect.Type",
+
+	// Packages with the names of variables used by the generated code
+	`"github.com/nathanjcochran/mock/testdata/src/shadow/fake"`: "fake.Item",
+	`"github.com/nathanjcochran/mock/testdata/src/shadow/mock"`: "*mock.Item",
+}
+
+// synthImportPaths are the keys of synthImports, in a deterministic order.
+var synthImportPaths = func() []string {
+	var paths []string
+	for path := range synthImports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}()
+
+// synthBasicTypes are the predeclared and package-level types that
+// synthesized types can refer to.
+var synthBasicTypes = []string{"int", "string", "bool", "byte", "float64", "error", "any", "Item", "Number"}
+
+// synthNames are the names given to synthesized params and results,
+// including some that are used by the generated code, and the names of
+// packages it imports.
+var synthNames = []string{"a", "b", "id", "value", "m", "args", "results", "exp", "stub", "n", "err", "ctx", "calls", "f", "fake", "dec", "rec", "rep", "ret", "mock",
+	"context", "match", "rand", "time", "slog", "atomic", "reflect"}
+
+func (s *synth) pick(list []string) string {
+	return list[s.rand.IntN(len(list))]
+}
+
+func (s *synth) chance(n int) bool {
+	return s.rand.IntN(n) == 0
+}
+
+// pkg synthesizes a package with several interfaces.
+func (s *synth) pkg(name string) string {
+	var body strings.Builder
+	body.WriteString(`
+// Item is a struct type declared in the package.
+type Item struct {
+	ID   string
+	Tags []string
+}
+
+// Generic is a generic type declared in the package.
+type Generic[T any] struct {
+	Value T
+}
+
+// Number is a union constraint declared in the package.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+`)
+	for i := range 1 + s.rand.IntN(3) {
+		body.WriteString(s.iface(fmt.Sprintf("Synth%d", i)))
+	}
+
+	var src strings.Builder
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", name)
+	for _, imp := range synthImportPaths {
+		if s.imports[imp] {
+			fmt.Fprintf(&src, "\t%s\n", imp)
+		}
+	}
+	src.WriteString(")\n")
+	src.WriteString(body.String())
+	return src.String()
+}
+
+// iface synthesizes an interface declaration.
+func (s *synth) iface(name string) string {
+	s.ifaces = append(s.ifaces, name)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\ntype %s", name)
+	s.typeParams = nil
+	if s.chance(3) {
+		var params []string
+		for i := range 1 + s.rand.IntN(2) {
+			typeParam := fmt.Sprintf("T%d", i)
+			s.typeParams = append(s.typeParams, typeParam)
+			params = append(params, fmt.Sprintf("%s %s", typeParam, s.pick([]string{"any", "comparable", "Number", "~string | ~[]byte", "interface{ ~int; String() string }"})))
+		}
+		fmt.Fprintf(&b, "[%s]", strings.Join(params, ", "))
+	}
+	b.WriteString(" interface {\n")
+	if s.chance(4) {
+		s.imports[`"io"`] = true
+		b.WriteString("\tio.Closer\n")
+	}
+	for i := range 1 + s.rand.IntN(4) {
+		fmt.Fprintf(&b, "\t%s\n", s.method(fmt.Sprintf("%s%d", s.pick([]string{"Get", "Do", "Put", "List", "Delete", "Run"}), i)))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// method synthesizes a method signature, without the func keyword.
+func (s *synth) method(name string) string {
+	var params []string
+	if s.chance(3) {
+		s.imports[`"context"`] = true
+		params = append(params, "context.Context")
+	}
+	for range s.rand.IntN(4) {
+		params = append(params, s.typ(2))
+	}
+	if len(params) > 0 && s.chance(4) {
+		params[len(params)-1] = "..." + params[len(params)-1]
+	}
+
+	var results []string
+	for range s.rand.IntN(3) {
+		results = append(results, s.typ(2))
+	}
+	if s.chance(2) {
+		results = append(results, "error")
+	}
+
+	used := map[string]bool{}
+	return fmt.Sprintf("%s(%s)%s", name, s.fields(params, true, used), s.resultList(results, used))
+}
+
+// fields names the params or results, either all with generated names
+// (some of them blank), or none of them. Names in used aren't reused.
+func (s *synth) fields(types []string, canBeBlank bool, used map[string]bool) string {
+	named := s.chance(2)
+	var fields []string
+	for _, typ := range types {
+		if !named {
+			fields = append(fields, typ)
+			continue
+		}
+		name := s.pick(synthNames)
+		if used[name] || (canBeBlank && s.chance(5)) {
+			name = "_"
+		}
+		used[name] = true
+		fields = append(fields, name+" "+typ)
+	}
+	return strings.Join(fields, ", ")
+}
+
+func (s *synth) resultList(results []string, used map[string]bool) string {
+	list := s.fields(results, false, used)
+	if len(results) == 0 {
+		return ""
+	}
+	if len(results) == 1 && !strings.Contains(list, " ") {
+		return " " + list
+	}
+	return " (" + list + ")"
+}
+
+// typ synthesizes a type, nesting composite types up to the given depth.
+func (s *synth) typ(depth int) string {
+	if depth == 0 || s.chance(3) {
+		switch {
+		case len(s.typeParams) > 0 && s.chance(3):
+			return s.pick(s.typeParams)
+		case s.chance(3):
+			imp := s.pick(synthImportPaths)
+			s.imports[imp] = true
+			return synthImports[imp]
+		default:
+			typ := s.pick(synthBasicTypes)
+			if typ == "Number" {
+				// Constraints can only be used as type arguments
+				return "Item"
+			}
+			return typ
+		}
+	}
+
+	switch s.rand.IntN(9) {
+	case 0:
+		return "[]" + s.typ(depth-1)
+	case 1:
+		return "[2]" + s.typ(depth-1)
+	case 2:
+		return fmt.Sprintf("map[%s]%s", s.pick([]string{"string", "int", "[2]int"}), s.typ(depth-1))
+	case 3:
+		return s.pick([]string{"chan ", "<-chan ", "chan<- "}) + s.typ(depth-1)
+	case 4:
+		return "*" + s.typ(depth-1)
+	case 5:
+		used := map[string]bool{}
+		return fmt.Sprintf("func(%s)%s", s.fields([]string{s.typ(depth - 1)}, true, used), s.resultList([]string{s.typ(depth - 1)}, used))
+	case 6:
+		return fmt.Sprintf("struct{ A %s; B %s }", s.typ(depth-1), s.typ(depth-1))
+	case 7:
+		return fmt.Sprintf("interface{ M(%s)%s }", s.typ(depth-1), s.resultList([]string{s.typ(depth - 1)}, map[string]bool{}))
+	default:
+		return "Generic[" + s.typ(depth-1) + "]"
+	}
+}
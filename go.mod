@@ -4,10 +4,11 @@ go 1.22.2
 
 require (
 	github.com/pkg/errors v0.9.1
+	golang.org/x/mod v0.17.0
 	golang.org/x/tools v0.20.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
-	golang.org/x/mod v0.17.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
 )
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nathanjcochran/mock/iface"
+	"golang.org/x/tools/go/packages"
+)
+
+var update = flag.Bool("update", false, "Update the golden files")
+
+// styleDeps are the styles whose output the output
+// of each style depends on in order to compile.
+var styleDeps = map[string][]string{
+	"record": {"mock"},
+}
+
+// gomockStub is the import path of a stub of go.uber.org/mock/gomock, which
+// the output of the gomock style is type-checked against instead, since this
+// module doesn't depend on go.uber.org/mock.
+const gomockStub = "github.com/nathanjcochran/mock/testdata/gomock"
+
+// checkedOutput returns the output of the style as it's type-checked.
+func checkedOutput(styleName string, output []byte) []byte {
+	if styleName != "gomock" {
+		return output
+	}
+	return bytes.ReplaceAll(output, []byte(`"go.uber.org/mock/gomock"`), []byte(strconv.Quote(gomockStub)))
+}
+
+// errorOutput is compared to the golden file instead of the output when
+// generating fails. Paths in the error are made relative to the module's
+// directory, so that the golden file doesn't depend on where it's checked out.
+func errorOutput(err error) []byte {
+	msg := err.Error()
+	if wd, err := os.Getwd(); err == nil {
+		msg = strings.ReplaceAll(msg, wd+string(filepath.Separator), "")
+	}
+	return []byte(fmt.Sprintf("error: %s\n", msg))
+}
+
+// TestGolden generates every style of implementation of every interface
+// declared in the packages under testdata/src, and compares the output to
+// the golden files under testdata/golden (or updates them, with -update).
+// The output is also type-checked along with the package it belongs to.
+func TestGolden(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "src", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			t.Parallel()
+			testGoldenPackage(t, dir)
+		})
+	}
+}
+
+func testGoldenPackage(t *testing.T, dir string) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loader, err := iface.NewLoader(iface.Config{Dir: dir, Tests: true}, ".")
+	if err != nil {
+		t.Fatalf("error loading package: %s", err)
+	}
+	ifaces, err := loader.Interfaces()
+	if err != nil {
+		t.Fatalf("error getting interfaces: %s", err)
+	}
+
+	styleNames := sortedStyleNames()
+	// Generate each style, keeping the output that
+	// compiles for type-checking, by file name
+	outputs := map[string]map[string][]byte{}
+	for _, styleName := range styleNames {
+		outputs[styleName] = map[string][]byte{}
+		for _, i := range ifaces {
+			outFile := filepath.Join(absDir, fmt.Sprintf("%s_%s.go", strings.ToLower(i.Name), styleName))
+			if i.Test {
+				outFile = strings.TrimSuffix(outFile, ".go") + "_test.go"
+			}
+			output, err := generate(i, styleName, naming{}, outFile)
+			if err != nil {
+				output = errorOutput(err)
+			} else {
+				outputs[styleName][outFile] = checkedOutput(styleName, output)
+			}
+			golden := filepath.Join("testdata", "golden", filepath.Base(dir), fmt.Sprintf("%s.%s.golden", i.Name, styleName))
+			checkGolden(t, golden, output)
+		}
+	}
+
+	for _, group := range checkGroups(styleNames, outputs) {
+		t.Run(strings.Join(group.styles, "+"), func(t *testing.T) {
+			typeCheck(t, dir, group.files)
+		})
+	}
+}
+
+// sortedStyleNames returns the names of the styles, in order.
+func sortedStyleNames() []string {
+	var names []string
+	for name := range styles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkGroup is a group of styles whose outputs
+// can be type-checked along with each other.
+type checkGroup struct {
+	styles []string
+	files  map[string][]byte
+	names  map[string]bool
+}
+
+// checkGroups groups the styles whose outputs can be type-checked together
+// (i.e. that don't declare the same names), since loading the package is
+// slow. Each style's output is checked along with the outputs it depends on.
+func checkGroups(styleNames []string, outputs map[string]map[string][]byte) []*checkGroup {
+	var groups []*checkGroup
+	for _, styleName := range styleNames {
+		if len(outputs[styleName]) == 0 {
+			continue
+		}
+
+		var group *checkGroup
+	groups:
+		for _, g := range groups {
+			for _, name := range styleDeps[styleName] {
+				if !slices.Contains(g.styles, name) {
+					continue groups
+				}
+			}
+			for name := range declaredNames(outputs[styleName]) {
+				if g.names[name] {
+					continue groups
+				}
+			}
+			group = g
+			break
+		}
+		if group == nil {
+			group = &checkGroup{files: map[string][]byte{}, names: map[string]bool{}}
+			groups = append(groups, group)
+			for _, name := range styleDeps[styleName] {
+				group.add(name, outputs[name])
+			}
+		}
+		group.add(styleName, outputs[styleName])
+	}
+	return groups
+}
+
+func (g *checkGroup) add(styleName string, files map[string][]byte) {
+	g.styles = append(g.styles, styleName)
+	for file, output := range files {
+		g.files[file] = output
+	}
+	for name := range declaredNames(files) {
+		g.names[name] = true
+	}
+}
+
+// declaredNames returns the names declared at the top level of the files
+// (other than methods and blank identifiers).
+func declaredNames(files map[string][]byte) map[string]bool {
+	names := map[string]bool{}
+	for file, src := range files {
+		f, err := parser.ParseFile(token.NewFileSet(), file, src, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					names[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						names[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							names[name.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	delete(names, "_")
+	return names
+}
+
+// checkGolden compares the output to the golden file,
+// or updates the golden file if the -update flag is set.
+func checkGolden(t *testing.T, golden string, output []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, output, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Errorf("error reading golden file (run with -update to create it): %s", err)
+		return
+	}
+	if string(want) != string(output) {
+		t.Errorf("output differs from %s (run with -update to update it):\n%s", golden, firstDiff(string(want), string(output)))
+	}
+}
+
+// firstDiff describes the first line at which got differs from want.
+func firstDiff(want, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var wantLine, gotLine string
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if wantLine != gotLine {
+			return fmt.Sprintf("line %d:\nwant: %s\ngot:  %s", i+1, wantLine, gotLine)
+		}
+	}
+	return ""
+}
+
+// typeCheck loads the package in dir, along with the given generated files,
+// and fails the test if it (or any of its dependencies) doesn't compile.
+func typeCheck(t *testing.T, dir string, overlay map[string][]byte) {
+	t.Helper()
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:    packages.LoadSyntax,
+		Dir:     dir,
+		Tests:   true,
+		Overlay: overlay,
+	}, ".")
+	if err != nil {
+		t.Fatalf("error loading package: %s", err)
+	}
+
+	// Test variants of the package repeat its errors
+	seen := map[string]bool{}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			if msg := err.Error(); !seen[msg] {
+				seen[msg] = true
+				t.Error(msg)
+			}
+		}
+	})
+}
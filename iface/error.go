@@ -1,14 +1,40 @@
 package iface
 
 import (
+	"errors"
 	"fmt"
+	"go/token"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/packages"
 )
 
+// Diagnostic is an error at a position in the source code (if known),
+// such as a type error, or a problem with the requested interface.
+type Diagnostic struct {
+	Position token.Position
+	Message  string
+
+	// Close matches for a misspelled name, if any
+	Suggestions []string
+}
+
+func (d *Diagnostic) Error() string {
+	msg := d.Message
+	if len(d.Suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(d.Suggestions, ", "))
+	}
+	if d.Position.IsValid() {
+		return fmt.Sprintf("%s: %s", d.Position, msg)
+	}
+	return msg
+}
+
+// TypeErrors are the errors in the declarations
+// of the types involved in an interface.
 type TypeErrors struct {
-	Errs []packages.Error
+	Errs []*Diagnostic
 }
 
 func (e *TypeErrors) Error() string {
@@ -18,3 +44,53 @@ func (e *TypeErrors) Error() string {
 	}
 	return fmt.Sprintf("encountered type errors: \n%s", strings.Join(strs, "\n"))
 }
+
+// Diagnostics returns the diagnostics that make up the error: the errors
+// of a *TypeErrors, a *Diagnostic, or else a diagnostic without a position
+// whose message is the error's.
+func Diagnostics(err error) []*Diagnostic {
+	var typeErrs *TypeErrors
+	if errors.As(err, &typeErrs) {
+		return typeErrs.Errs
+	}
+	var diag *Diagnostic
+	if errors.As(err, &diag) {
+		return []*Diagnostic{diag}
+	}
+	return []*Diagnostic{{Message: err.Error()}}
+}
+
+// newDiagnostic converts an error reported while loading a package.
+func newDiagnostic(err packages.Error) *Diagnostic {
+	return &Diagnostic{
+		Position: parsePosition(err.Pos),
+		Message:  err.Msg,
+	}
+}
+
+// parsePosition parses a position of the form "file:line:col" or
+// "file:line", as reported by the go/packages package. Anything
+// else (e.g. "" or "-") is parsed as an invalid position.
+func parsePosition(pos string) token.Position {
+	var nums []int
+	for range 2 {
+		i := strings.LastIndex(pos, ":")
+		if i < 0 {
+			break
+		}
+		n, err := strconv.Atoi(pos[i+1:])
+		if err != nil {
+			break
+		}
+		nums = append([]int{n}, nums...)
+		pos = pos[:i]
+	}
+	if len(nums) == 0 {
+		return token.Position{}
+	}
+	position := token.Position{Filename: pos, Line: nums[0]}
+	if len(nums) > 1 {
+		position.Column = nums[1]
+	}
+	return position
+}
@@ -2,74 +2,55 @@ package iface
 
 import (
 	"fmt"
-	"go/token"
 	"go/types"
 	"sort"
-	"strings"
-
-	"github.com/pkg/errors"
-	"golang.org/x/tools/go/packages"
 )
 
+// GetInterface loads the package in the given directory and returns
+// information about the named interface. To look in the package's test
+// files too, use a Loader configured with Config.Tests.
 func GetInterface(dir, ifaceName string) (Interface, error) {
-	cfg := &packages.Config{Mode: packages.LoadSyntax}
-	pkgs, err := packages.Load(cfg, dir)
+	loader, err := NewLoader(Config{}, dir)
 	if err != nil {
-		return Interface{}, errors.Wrap(err, "error loading package info")
-	}
-
-	if len(pkgs) < 1 {
-		return Interface{}, errors.Wrap(err, "failed to find/load package info")
-	} else if len(pkgs) > 1 {
-		return Interface{}, errors.Wrap(err, "found more than one matching package")
-	}
-	pkg := pkgs[0]
-
-	// Keep track of each file's imports, along with their name (if renamed)
-	fileImps := map[token.Pos][]Import{}
-	for _, fileAST := range pkg.Syntax {
-		var imps []Import
-		for _, fileImp := range fileAST.Imports {
-			imp := Import{
-				Path: strings.Trim(fileImp.Path.Value, "\""),
-			}
-			if fileImp.Name != nil {
-				imp.Name = fileImp.Name.Name
-			}
-			imps = append(imps, imp)
-		}
-		fileImps[pkg.Fset.File(fileAST.Pos()).Pos(0)] = imps
+		return Interface{}, err
 	}
+	return loader.Interface(ifaceName)
+}
 
-	// Find the interface by name
-	ifaceObj := pkg.Types.Scope().Lookup(ifaceName)
-	if ifaceObj == nil {
-		return Interface{}, errors.Errorf("interface not found in package: %s", ifaceName)
-	}
+func newInterface(pkg *loadedPackage, ifaceObj types.Object) (Interface, error) {
+	ifaceName := ifaceObj.Name()
 
 	// Validate that the object with that name
 	// is indeed an interface
+	position := pkg.Fset.Position(ifaceObj.Pos())
 	if _, ok := ifaceObj.(*types.TypeName); !ok {
-		return Interface{}, fmt.Errorf("%s is not a named/defined type", ifaceName)
+		return Interface{}, &Diagnostic{Position: position, Message: fmt.Sprintf("%s is not a named/defined type", ifaceName)}
 	}
 	ifaceType, ok := ifaceObj.Type().Underlying().(*types.Interface)
 	if !ok {
-		return Interface{}, fmt.Errorf("%s is not an interface type", ifaceName)
+		return Interface{}, &Diagnostic{Position: position, Message: fmt.Sprintf("%s is not an interface type", ifaceName)}
+	}
+	if !ifaceType.IsMethodSet() {
+		return Interface{}, &Diagnostic{Position: position, Message: fmt.Sprintf("%s is a type constraint, which can't be implemented", ifaceName)}
 	}
 
-	// Make sure that none of the types involved in the
-	// interface's definition were invalid/had errors
-	if !ValidateType(ifaceObj.Type()) {
-		return Interface{}, &TypeErrors{Errs: pkg.Errors}
+	// Make sure that none of the types that implementations
+	// of the interface refer to were invalid/had errors
+	if !validateInterface(ifaceObj.Type()) {
+		return Interface{}, &TypeErrors{Errs: pkg.typeErrors(ifaceObj)}
 	}
 
 	// Get the file's imports
-	imps := fileImps[pkg.Fset.File(ifaceObj.Pos()).Pos(0)]
+	imps := pkg.fileImps[pkg.Fset.File(ifaceObj.Pos()).Pos(0)]
 
 	// Begin assembling information about the interface
 	iface := Interface{
-		Package: pkg.Name,
-		Name:    ifaceObj.Name(),
+		Package:  pkg.Name,
+		PkgPath:  pkg.PkgPath,
+		Name:     ifaceName,
+		Doc:      pkg.docs[ifaceObj.Pos()],
+		Position: position,
+		Test:     inTestFile(pkg.Package, ifaceObj),
 	}
 	qualifier := Qualify(pkg.Types, imps, &iface.Imports)
 
@@ -86,18 +67,27 @@ func GetInterface(dir, ifaceName string) (Interface, error) {
 	}
 
 	// Iterate through each embedded interface's explicit methods
-	for _, ifaceType := range explodeInterface(ifaceType) {
+	for _, embedded := range explodeInterface(ifaceType) {
+		ifaceType := embedded.iface
 		for i := range ifaceType.NumExplicitMethods() {
 			methodObj := ifaceType.ExplicitMethod(i)
 			method := Method{
 				Name:     methodObj.Name(),
+				Doc:      pkg.docs[methodObj.Pos()],
+				Position: pkg.Fset.Position(methodObj.Pos()),
 				srcIface: ifaceType.String(),
 				pos:      methodObj.Pos(),
 			}
+			if embedded.named != nil {
+				method.Embedded = types.TypeString(embedded.named, types.RelativeTo(pkg.Types))
+			}
+			if !methodObj.Exported() {
+				method.PkgPath = methodObj.Pkg().Path()
+			}
 
 			sig, ok := methodObj.Type().(*types.Signature)
 			if !ok {
-				return Interface{}, fmt.Errorf("%s is not a method signature", methodObj.Name())
+				return Interface{}, &Diagnostic{Position: method.Position, Message: fmt.Sprintf("%s is not a method signature", methodObj.Name())}
 			}
 
 			// Keep track of the names and types of the parameters
@@ -105,8 +95,9 @@ func GetInterface(dir, ifaceName string) (Interface, error) {
 			for j := 0; j < paramsTuple.Len(); j++ {
 				paramObj := paramsTuple.At(j)
 				param := Param{
-					Name: paramObj.Name(),
-					Type: types.TypeString(paramObj.Type(), qualifier),
+					Name:   paramObj.Name(),
+					Type:   types.TypeString(paramObj.Type(), qualifier),
+					GoType: paramObj.Type(),
 				}
 				method.Params = append(method.Params, param)
 			}
@@ -121,8 +112,9 @@ func GetInterface(dir, ifaceName string) (Interface, error) {
 			for j := 0; j < resultsTuple.Len(); j++ {
 				resultObj := resultsTuple.At(j)
 				result := Result{
-					Name: resultObj.Name(),
-					Type: types.TypeString(resultObj.Type(), qualifier),
+					Name:   resultObj.Name(),
+					Type:   types.TypeString(resultObj.Type(), qualifier),
+					GoType: resultObj.Type(),
 				}
 				method.Results = append(method.Results, result)
 			}
@@ -137,29 +129,37 @@ func GetInterface(dir, ifaceName string) (Interface, error) {
 	return iface, nil
 }
 
+// embeddedInterface is an interface type making up part of another
+// interface, along with the named type it came from (nil if it was
+// the original interface, or embedded as an interface literal).
+type embeddedInterface struct {
+	iface *types.Interface
+	named *types.Named
+}
+
 // explodeInterface traverses an interface type, returning the original
 // interface along with all transitively embedded interfaces.
-func explodeInterface(iface *types.Interface) []*types.Interface {
+func explodeInterface(iface *types.Interface) []embeddedInterface {
 	var (
-		result    []*types.Interface
-		workQueue = []*types.Interface{iface}
+		result    []embeddedInterface
+		workQueue = []embeddedInterface{{iface: iface}}
 		visited   = map[string]bool{}
 	)
 	for len(workQueue) > 0 {
 		current := workQueue[0]
 		workQueue = workQueue[1:]
-		currentID := current.String()
+		currentID := current.iface.String()
 		if !visited[currentID] {
 			visited[currentID] = true
 			result = append(result, current)
-			for i := range current.NumEmbeddeds() {
-				switch embedded := current.EmbeddedType(i).(type) {
+			for i := range current.iface.NumEmbeddeds() {
+				switch embedded := current.iface.EmbeddedType(i).(type) {
 				case *types.Interface:
-					workQueue = append(workQueue, embedded)
+					workQueue = append(workQueue, embeddedInterface{iface: embedded})
 				case *types.Named:
 					switch underlying := embedded.Underlying().(type) {
 					case *types.Interface:
-						workQueue = append(workQueue, underlying)
+						workQueue = append(workQueue, embeddedInterface{iface: underlying, named: embedded})
 					}
 				}
 			}
@@ -231,93 +231,131 @@ func Qualify(pkg *types.Package, imps []Import, usedImps *[]Import) types.Qualif
 	}
 }
 
+// ValidateType reports whether the type, and all of
+// the types it's composed of, are valid.
 func ValidateType(typ types.Type) bool {
-	return validateType(typ, &[]types.Type{})
+	valid := true
+	visitTypes(typ, func(typ types.Type) bool {
+		if basic, ok := typ.(*types.Basic); ok && basic.Kind() == types.Invalid {
+			valid = false
+		}
+		return true
+	})
+	return valid
 }
 
-func validateType(typ types.Type, visited *[]types.Type) bool {
-	for _, t := range *visited {
-		if t == typ {
-			return true
+// validateInterface reports whether the types that implementations of the
+// (possibly generic) interface type refer to are valid: the types in its
+// methods' signatures, and its type parameters' constraints. Named types
+// are only referred to by name, so their underlying types aren't checked,
+// which allows an implementation to be generated while the declarations
+// of the types it refers to (or of anything else) are broken.
+func validateInterface(typ types.Type) bool {
+	valid := true
+	var visit func(types.Type) bool
+	visit = func(typ types.Type) bool {
+		switch t := typ.(type) {
+		case *types.Basic:
+			if t.Kind() == types.Invalid {
+				valid = false
+			}
+		case *types.Named:
+			typeArgs := t.TypeArgs()
+			for i := range typeArgs.Len() {
+				visitTypes(typeArgs.At(i), visit)
+			}
+			return false
 		}
+		return true
 	}
-	*visited = append(*visited, typ)
 
-	switch t := typ.(type) {
-	case nil:
-		return true
+	if named, ok := typ.(*types.Named); ok {
+		typeParams := named.TypeParams()
+		for i := range typeParams.Len() {
+			visitTypes(typeParams.At(i).Constraint(), visit)
+		}
+	}
+	for _, embedded := range explodeInterface(typ.Underlying().(*types.Interface)) {
+		for i := range embedded.iface.NumEmbeddeds() {
+			visitTypes(embedded.iface.EmbeddedType(i), visit)
+		}
+		for i := range embedded.iface.NumExplicitMethods() {
+			visitTypes(embedded.iface.ExplicitMethod(i).Type(), visit)
+		}
+	}
+	return valid
+}
+
+// visitTypes calls visit for the type, and each of the types it's
+// composed of (including the underlying types of named types, and their
+// type arguments and type parameters' constraints), once each, skipping
+// the components of any type for which visit returns false.
+func visitTypes(typ types.Type, visit func(types.Type) bool) {
+	walkTypes(typ, map[types.Type]bool{}, visit)
+}
 
-	case *types.Basic:
-		return t.Kind() != types.Invalid
+func walkTypes(typ types.Type, visited map[types.Type]bool, visit func(types.Type) bool) {
+	if typ == nil || visited[typ] {
+		return
+	}
+	visited[typ] = true
+	if !visit(typ) {
+		return
+	}
 
+	switch t := typ.(type) {
 	case *types.Array:
-		return validateType(t.Elem(), visited)
+		walkTypes(t.Elem(), visited, visit)
 
 	case *types.Slice:
-		return validateType(t.Elem(), visited)
+		walkTypes(t.Elem(), visited, visit)
 
 	case *types.Struct:
 		for i := range t.NumFields() {
-			if !validateType(t.Field(i).Type(), visited) {
-				return false
-			}
+			walkTypes(t.Field(i).Type(), visited, visit)
 		}
-		return true
 
 	case *types.Pointer:
-		return validateType(t.Elem(), visited)
+		walkTypes(t.Elem(), visited, visit)
 
 	case *types.Tuple:
 		for i := range t.Len() {
-			if !validateType(t.At(i).Type(), visited) {
-				return false
-			}
+			walkTypes(t.At(i).Type(), visited, visit)
 		}
-		return true
 
 	case *types.Signature:
-		return validateType(t.Params(), visited) &&
-			validateType(t.Results(), visited)
+		walkTypes(t.Params(), visited, visit)
+		walkTypes(t.Results(), visited, visit)
 
 	case *types.Interface:
 		for i := range t.NumEmbeddeds() {
-			if !validateType(t.EmbeddedType(i), visited) {
-				return false
-			}
+			walkTypes(t.EmbeddedType(i), visited, visit)
 		}
 		for i := range t.NumMethods() {
-			if !validateType(t.Method(i).Type(), visited) {
-				return false
-			}
+			walkTypes(t.Method(i).Type(), visited, visit)
 		}
-		return true
 
 	case *types.Union:
 		for i := range t.Len() {
-			if !validateType(t.Term(i).Type(), visited) {
-				return false
-			}
+			walkTypes(t.Term(i).Type(), visited, visit)
 		}
-		return true
 
 	case *types.Map:
-		return validateType(t.Elem(), visited) &&
-			validateType(t.Key(), visited)
+		walkTypes(t.Elem(), visited, visit)
+		walkTypes(t.Key(), visited, visit)
 
 	case *types.Chan:
-		return validateType(t.Elem(), visited)
+		walkTypes(t.Elem(), visited, visit)
 
 	case *types.Named:
 		typeParams := t.TypeParams()
 		for i := range typeParams.Len() {
-			if !validateType(typeParams.At(i).Constraint(), visited) {
-				return false
-			}
+			walkTypes(typeParams.At(i).Constraint(), visited, visit)
 		}
-		return validateType(t.Underlying(), visited)
-
-	default:
-		// log.Printf("Unknown types.Type: %v", t)
-		return true
+		typeArgs := t.TypeArgs()
+		for i := range typeArgs.Len() {
+			walkTypes(typeArgs.At(i), visited, visit)
+		}
+		walkTypes(t.Underlying(), visited, visit)
 	}
 }
@@ -4,15 +4,34 @@ import (
 	"cmp"
 	"fmt"
 	"go/token"
+	"go/types"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type Interface struct {
 	Name       string
 	TypeParams TypeParams
 	Package    string
+	PkgPath    string
 	Imports    []Import
 	Methods    Methods
+
+	// Doc comment of the interface's type declaration (if any)
+	Doc string
+
+	// Source position of the interface's name
+	Position token.Position
+
+	// Whether the interface is declared in a _test.go file, in which
+	// case the mock must also be written to a _test.go file
+	Test bool
+
+	// Whether the interface was read from its syntax alone, because it
+	// had type errors (see Config.SyntaxFallback), in which case the
+	// params' and results' GoTypes are nil
+	SyntaxOnly bool
 }
 
 type TypeParam struct {
@@ -67,13 +86,50 @@ type Method struct {
 	Params  Params
 	Results Results
 
+	// Doc comment of the method (if its source was loaded)
+	Doc string
+
+	// Source position of the method's name
+	Position token.Position
+
+	// Named interface the method was inherited from, when it comes from
+	// an embedded interface (e.g. "io.Reader"), or empty if the method
+	// was declared directly in the mocked interface or an interface literal
+	Embedded string
+
+	// Import path of the package an unexported method belongs to (which is
+	// the only package that can implement it), or empty if it's exported
+	PkgPath string
+
 	// String representation of the interface explicitly requiring this method
 	srcIface string
 	pos      token.Pos
 }
 
+// TakesContext reports whether the method's first parameter is a
+// context.Context.
+func (m *Method) TakesContext() bool {
+	return len(m.Params) > 0 && m.Params[0].IsContext()
+}
+
+// ReturnsError reports whether the method's last result is an error.
+func (m *Method) ReturnsError() bool {
+	return len(m.Results) > 0 && m.Results[len(m.Results)-1].IsError()
+}
+
 type Methods []Method
 
+// ReturningError returns the methods whose last result is an error.
+func (m Methods) ReturningError() Methods {
+	var methods Methods
+	for _, method := range m {
+		if method.ReturnsError() {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
 func (m Methods) Len() int      { return len(m) }
 func (m Methods) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
 func (m Methods) Less(i, j int) bool {
@@ -94,6 +150,9 @@ type Param struct {
 	Name     string
 	Type     string
 	Variadic bool
+
+	// Type checker's representation of the parameter's type
+	GoType types.Type
 }
 
 func (p *Param) String() string {
@@ -110,8 +169,35 @@ func (p *Param) TypeString() string {
 	return p.Type
 }
 
+// IsContext reports whether the parameter is a context.Context.
+func (p *Param) IsContext() bool {
+	if p.GoType == nil {
+		return p.Type == "context.Context"
+	}
+	named, ok := p.GoType.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
 type Params []Param
 
+// Names returns the name of each parameter, substituting a
+// generated name for unnamed and blank parameters.
+func (ps Params) Names() []string {
+	var names []string
+	for i, p := range ps {
+		name := p.Name
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("param%d", i+1)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
 func (ps Params) String() string {
 	var strs []string
 	for _, p := range ps {
@@ -120,27 +206,39 @@ func (ps Params) String() string {
 	return strings.Join(strs, ", ")
 }
 
-func (ps Params) NamedString() string {
-	var strs []string
+// FieldNames returns an exported struct field name for each parameter:
+// the parameter's name, capitalized, or a generated name for unnamed
+// and blank parameters.
+func (ps Params) FieldNames() []string {
+	var names []string
 	for i, p := range ps {
-		name := p.Name
-		if name == "" || name == "_" {
-			name = fmt.Sprintf("param%d", i+1)
-		}
+		names = append(names, fieldName(p.Name, "Param", i))
+	}
+	return names
+}
+
+// TypesString returns the parameter list without any names, for use
+// in function literals that don't refer to their parameters.
+func (ps Params) TypesString() string {
+	var strs []string
+	for _, p := range ps {
+		strs = append(strs, p.TypeString())
+	}
+	return strings.Join(strs, ", ")
+}
 
-		strs = append(strs, fmt.Sprintf("%s %s", name, p.TypeString()))
+func (ps Params) NamedString() string {
+	var strs []string
+	for i, name := range ps.Names() {
+		strs = append(strs, fmt.Sprintf("%s %s", name, ps[i].TypeString()))
 	}
 	return strings.Join(strs, ", ")
 }
 
 func (ps Params) ArgsString() string {
 	var args []string
-	for i, param := range ps {
-		arg := param.Name
-		if arg == "" || arg == "_" {
-			arg = fmt.Sprintf("param%d", i+1)
-		}
-		if param.Variadic {
+	for i, arg := range ps.Names() {
+		if ps[i].Variadic {
 			arg = fmt.Sprintf("%s...", arg)
 		}
 		args = append(args, arg)
@@ -151,6 +249,9 @@ func (ps Params) ArgsString() string {
 type Result struct {
 	Name string
 	Type string
+
+	// Type checker's representation of the result's type
+	GoType types.Type
 }
 
 func (r *Result) String() string {
@@ -160,8 +261,73 @@ func (r *Result) String() string {
 	return r.Type
 }
 
+// IsError reports whether the result is an error.
+func (r *Result) IsError() bool {
+	if r.GoType == nil {
+		return r.Type == "error"
+	}
+	return types.Identical(r.GoType, types.Universe.Lookup("error").Type())
+}
+
 type Results []Result
 
+// Names returns the name of each result, substituting a
+// generated name for unnamed and blank results.
+func (rs Results) Names() []string {
+	var names []string
+	for i, r := range rs {
+		name := r.Name
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("result%d", i+1)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// FieldNames returns an exported struct field name for each result:
+// the result's name, capitalized, or a generated name for unnamed
+// and blank results.
+func (rs Results) FieldNames() []string {
+	var names []string
+	for i, r := range rs {
+		names = append(names, fieldName(r.Name, "Result", i))
+	}
+	return names
+}
+
+// NamedString returns the result list with every result named,
+// for use in function signatures that assign to their results.
+func (rs Results) NamedString() string {
+	if len(rs) == 0 {
+		return ""
+	}
+	var strs []string
+	for i, name := range rs.Names() {
+		strs = append(strs, fmt.Sprintf("%s %s", name, rs[i].Type))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(strs, ", "))
+}
+
+// VarsString returns the comma-separated names of the results,
+// as named by NamedString.
+func (rs Results) VarsString() string {
+	return strings.Join(rs.Names(), ", ")
+}
+
+// TypesString returns the result list without any names, for use in
+// function literals whose results mustn't shadow surrounding variables.
+func (rs Results) TypesString() string {
+	var strs []string
+	for _, r := range rs {
+		strs = append(strs, r.Type)
+	}
+	if len(strs) > 1 {
+		return fmt.Sprintf("(%s)", strings.Join(strs, ", "))
+	}
+	return strings.Join(strs, ", ")
+}
+
 func (rs Results) String() string {
 	var (
 		strs  []string
@@ -178,3 +344,13 @@ func (rs Results) String() string {
 	}
 	return strings.Join(strs, ", ")
 }
+
+// fieldName capitalizes a param or result name for use as an exported
+// struct field, or generates a name for unnamed and blank ones.
+func fieldName(name, prefix string, i int) string {
+	if name == "" || name == "_" {
+		return fmt.Sprintf("%s%d", prefix, i+1)
+	}
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
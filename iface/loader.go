@@ -0,0 +1,395 @@
+package iface
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/packages"
+)
+
+// Config controls how a Loader finds and loads packages.
+type Config struct {
+	// Directory in which to run the build system's query tool
+	// (default: the current working directory)
+	Dir string
+
+	// Whether to also load the packages' _test.go files
+	Tests bool
+
+	// Whether to fall back to reading an interface from its syntax alone,
+	// without type checking, if the types it refers to have errors (or the
+	// package couldn't be type checked at all)
+	SyntaxFallback bool
+}
+
+// Loader loads a set of packages once, and extracts information
+// about the interfaces declared in them on demand.
+type Loader struct {
+	cfg  Config
+	pkgs []*loadedPackage
+}
+
+// loadedPackage is a loaded package, along with the syntax-level
+// information that isn't available from its type information.
+type loadedPackage struct {
+	*packages.Package
+
+	// Each file's imports, along with their name (if renamed),
+	// keyed by the file's base position
+	fileImps map[token.Pos][]Import
+
+	// Doc comments of type specs and interface methods,
+	// keyed by the position of their name
+	docs map[token.Pos]string
+
+	// Type specs, keyed by the position of their name,
+	// and each file's import declarations, keyed by the
+	// file's base position
+	specs    map[token.Pos]*ast.TypeSpec
+	impDecls map[token.Pos][]*ast.GenDecl
+}
+
+// NewLoader loads the packages matching the given patterns.
+func NewLoader(cfg Config, patterns ...string) (*Loader, error) {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.LoadSyntax,
+		Dir:   cfg.Dir,
+		Tests: cfg.Tests,
+	}, patterns...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading package info")
+	}
+
+	loader := &Loader{cfg: cfg}
+	for _, pkg := range pkgs {
+		// Skip the generated test binary (i.e. "path/to/pkg.test")
+		if pkg.Name == "main" && strings.HasSuffix(pkg.ID, ".test") {
+			continue
+		}
+		loader.pkgs = append(loader.pkgs, newLoadedPackage(pkg))
+	}
+	if len(loader.pkgs) < 1 {
+		return nil, errors.New("failed to find/load package info")
+	}
+
+	// Order each package's variants: the package itself, then the package
+	// compiled with its _test.go files, then the external _test package.
+	sort.SliceStable(loader.pkgs, func(i, j int) bool {
+		pathI, pathJ := basePath(loader.pkgs[i].Package), basePath(loader.pkgs[j].Package)
+		if pathI != pathJ {
+			return pathI < pathJ
+		}
+		return variantRank(loader.pkgs[i].Package) < variantRank(loader.pkgs[j].Package)
+	})
+
+	return loader, nil
+}
+
+func newLoadedPackage(pkg *packages.Package) *loadedPackage {
+	loaded := &loadedPackage{
+		Package:  pkg,
+		fileImps: map[token.Pos][]Import{},
+		docs:     map[token.Pos]string{},
+		specs:    map[token.Pos]*ast.TypeSpec{},
+		impDecls: map[token.Pos][]*ast.GenDecl{},
+	}
+	for _, fileAST := range pkg.Syntax {
+		var imps []Import
+		for _, fileImp := range fileAST.Imports {
+			imp := Import{
+				Path: strings.Trim(fileImp.Path.Value, "\""),
+			}
+			if fileImp.Name != nil {
+				imp.Name = fileImp.Name.Name
+			}
+			imps = append(imps, imp)
+		}
+		base := pkg.Fset.File(fileAST.Pos()).Pos(0)
+		loaded.fileImps[base] = imps
+		for _, decl := range fileAST.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.IMPORT {
+				loaded.impDecls[base] = append(loaded.impDecls[base], decl)
+			}
+		}
+
+		ast.Inspect(fileAST, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.GenDecl:
+				// A lone type spec's doc comment is attached to its declaration
+				if node.Tok == token.TYPE && len(node.Specs) == 1 && node.Doc != nil {
+					spec := node.Specs[0].(*ast.TypeSpec)
+					if spec.Doc == nil {
+						loaded.docs[spec.Name.Pos()] = node.Doc.Text()
+					}
+				}
+			case *ast.TypeSpec:
+				loaded.specs[node.Name.Pos()] = node
+				if node.Doc != nil {
+					loaded.docs[node.Name.Pos()] = node.Doc.Text()
+				}
+			case *ast.InterfaceType:
+				for _, field := range node.Methods.List {
+					if field.Doc != nil && len(field.Names) > 0 {
+						loaded.docs[field.Names[0].Pos()] = field.Doc.Text()
+					}
+				}
+			}
+			return true
+		})
+	}
+	return loaded
+}
+
+// typeErrors returns the package's errors that are within the declarations
+// of the package's types that are reachable from the object's type, or the
+// imports of the files declaring them. If there are none (e.g. because a
+// dependency failed to load in a way that isn't reported at an import, or
+// the errors have no positions), it returns all of them.
+func (pkg *loadedPackage) typeErrors(obj types.Object) []*Diagnostic {
+	var spans []span
+	addSpan := func(node ast.Node) {
+		spans = append(spans, span{
+			start: pkg.Fset.Position(node.Pos()),
+			end:   pkg.Fset.Position(node.End()),
+		})
+	}
+	visitTypes(obj.Type(), func(typ types.Type) bool {
+		named, ok := typ.(*types.Named)
+		if !ok || named.Obj().Pkg() != pkg.Types {
+			return true
+		}
+		if spec := pkg.specs[named.Obj().Pos()]; spec != nil {
+			addSpan(spec)
+			for _, decl := range pkg.impDecls[pkg.Fset.File(spec.Pos()).Pos(0)] {
+				addSpan(decl)
+			}
+		}
+		return true
+	})
+
+	var all, reachable []*Diagnostic
+	for _, err := range pkg.Errors {
+		diag := newDiagnostic(err)
+		all = append(all, diag)
+		for _, s := range spans {
+			if s.contains(diag.Position) {
+				reachable = append(reachable, diag)
+				break
+			}
+		}
+	}
+	if len(reachable) == 0 {
+		return all
+	}
+	return reachable
+}
+
+// span is the range of positions covered by a syntax node.
+type span struct {
+	start, end token.Position
+}
+
+func (s span) contains(pos token.Position) bool {
+	if pos.Filename != s.start.Filename {
+		return false
+	}
+	// An error without a column covers the whole line
+	if pos.Column == 0 {
+		return s.start.Line <= pos.Line && pos.Line <= s.end.Line
+	}
+	return !before(pos, s.start) && before(pos, s.end)
+}
+
+// before reports whether position a is before position b in the same file.
+func before(a, b token.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+// Packages returns the loaded packages, excluding generated test binaries.
+func (l *Loader) Packages() []*packages.Package {
+	var pkgs []*packages.Package
+	for _, pkg := range l.pkgs {
+		pkgs = append(pkgs, pkg.Package)
+	}
+	return pkgs
+}
+
+// Interface returns information about the named interface. The loaded
+// packages must all be variants of a single package. The first variant
+// declaring the interface wins: the package itself, then the package
+// compiled with its _test.go files, then the external _test package.
+func (l *Loader) Interface(ifaceName string) (Interface, error) {
+	paths := map[string]bool{}
+	for _, pkg := range l.pkgs {
+		paths[basePath(pkg.Package)] = true
+	}
+	if len(paths) > 1 {
+		return Interface{}, errors.New("found more than one matching package")
+	}
+
+	for _, pkg := range l.pkgs {
+		if pkg.Types != nil {
+			if obj := pkg.Types.Scope().Lookup(ifaceName); obj != nil {
+				iface, err := newInterface(pkg, obj)
+				var typeErrs *TypeErrors
+				if l.cfg.SyntaxFallback && errors.As(err, &typeErrs) {
+					if spec := pkg.lookupSpec(ifaceName); spec != nil {
+						return newSyntaxInterface(pkg, spec)
+					}
+				}
+				return iface, err
+			}
+		}
+		if l.cfg.SyntaxFallback {
+			if spec := pkg.lookupSpec(ifaceName); spec != nil {
+				return newSyntaxInterface(pkg, spec)
+			}
+		}
+	}
+	return Interface{}, &Diagnostic{
+		Message:     fmt.Sprintf("interface %s not found in package %s", ifaceName, l.pkgs[0].Name),
+		Suggestions: l.closeMatches(ifaceName),
+	}
+}
+
+// closeMatches returns the names of the interfaces declared in the loaded
+// packages that are close to the given (presumably misspelled) name, from
+// closest to furthest: those within an edit distance of a third of its
+// length, ignoring case.
+func (l *Loader) closeMatches(name string) []string {
+	maxDist := max(1, len(name)/3)
+	dists := map[string]int{}
+	for _, pkg := range l.pkgs {
+		if pkg.Types == nil {
+			continue
+		}
+		scope := pkg.Types.Scope()
+		for _, other := range scope.Names() {
+			if _, ok := scope.Lookup(other).(*types.TypeName); !ok {
+				continue
+			}
+			if t, ok := scope.Lookup(other).Type().Underlying().(*types.Interface); !ok || !t.IsMethodSet() {
+				continue
+			}
+			if dist := editDistance(strings.ToLower(name), strings.ToLower(other)); dist <= maxDist {
+				dists[other] = dist
+			}
+		}
+	}
+
+	var matches []string
+	for match := range dists {
+		matches = append(matches, match)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if dists[matches[i]] != dists[matches[j]] {
+			return dists[matches[i]] < dists[matches[j]]
+		}
+		return matches[i] < matches[j]
+	})
+	if len(matches) > 3 {
+		matches = matches[:3]
+	}
+	return matches
+}
+
+// editDistance returns the number of insertions, deletions, substitutions
+// and transpositions of adjacent characters needed to turn a into b (the
+// optimal string alignment distance).
+func editDistance(a, b string) int {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+		dist[i][0] = i
+	}
+	for j := range dist[0] {
+		dist[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			dist[i][j] = min(dist[i-1][j]+1, dist[i][j-1]+1, dist[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				dist[i][j] = min(dist[i][j], dist[i-2][j-2]+1)
+			}
+		}
+	}
+	return dist[len(a)][len(b)]
+}
+
+// Interfaces returns information about all of the interface types declared
+// at the top level of the loaded packages (other than type constraints),
+// ordered by package and then by position. Interfaces declared in non-test
+// files are only returned once, even if test variants of their package were
+// loaded.
+func (l *Loader) Interfaces() ([]Interface, error) {
+	var ifaces []Interface
+	for _, pkg := range l.pkgs {
+		if pkg.Types == nil {
+			continue
+		}
+		scope := pkg.Types.Scope()
+		var objs []types.Object
+		for _, name := range scope.Names() {
+			obj := scope.Lookup(name)
+			if _, ok := obj.(*types.TypeName); !ok {
+				continue
+			}
+			// Skip non-interfaces, and type constraints
+			// (which can't be implemented)
+			if t, ok := obj.Type().Underlying().(*types.Interface); !ok || !t.IsMethodSet() {
+				continue
+			}
+
+			// The package's test variant also contains the declarations
+			// from its non-test files, which were already returned
+			if variantRank(pkg.Package) == 1 && !inTestFile(pkg.Package, obj) {
+				continue
+			}
+			objs = append(objs, obj)
+		}
+		sort.Slice(objs, func(i, j int) bool {
+			return objs[i].Pos() < objs[j].Pos()
+		})
+
+		for _, obj := range objs {
+			iface, err := newInterface(pkg, obj)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error getting interface %s.%s", pkg.PkgPath, obj.Name())
+			}
+			ifaces = append(ifaces, iface)
+		}
+	}
+	return ifaces, nil
+}
+
+// basePath returns the import path of the package, ignoring test variants.
+func basePath(pkg *packages.Package) string {
+	return strings.TrimSuffix(pkg.PkgPath, "_test")
+}
+
+// variantRank orders the variants of a package: the package itself, then the
+// package compiled with its _test.go files, then the external _test package.
+func variantRank(pkg *packages.Package) int {
+	switch {
+	case strings.HasSuffix(pkg.PkgPath, "_test"):
+		return 2
+	case pkg.ID != pkg.PkgPath:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// inTestFile reports whether the object is declared in a _test.go file.
+func inTestFile(pkg *packages.Package, obj types.Object) bool {
+	return strings.HasSuffix(pkg.Fset.Position(obj.Pos()).Filename, "_test.go")
+}
@@ -0,0 +1,183 @@
+package iface
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// lookupSpec returns the package-level type spec with the given name.
+func (pkg *loadedPackage) lookupSpec(name string) *ast.TypeSpec {
+	for _, fileAST := range pkg.Syntax {
+		for _, decl := range fileAST.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok && spec.Name.Name == name {
+					return spec
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newSyntaxInterface returns information about the interface declared by
+// the type spec, working from its syntax alone, without type checking. Types
+// are written as they are in the source code, and all of the imports of the
+// interface's file are included (goimports removes those that end up
+// unused). The interfaces it embeds must be declared in the same package,
+// since their methods can't be found otherwise.
+func newSyntaxInterface(pkg *loadedPackage, spec *ast.TypeSpec) (Interface, error) {
+	position := pkg.Fset.Position(spec.Name.Pos())
+	ifaceType, ok := spec.Type.(*ast.InterfaceType)
+	if !ok {
+		return Interface{}, &Diagnostic{Position: position, Message: fmt.Sprintf("%s is not an interface type", spec.Name.Name)}
+	}
+
+	file := pkg.Fset.File(spec.Pos())
+	iface := Interface{
+		Package:    pkg.Name,
+		PkgPath:    pkg.PkgPath,
+		Name:       spec.Name.Name,
+		Doc:        pkg.docs[spec.Name.Pos()],
+		Position:   position,
+		Test:       strings.HasSuffix(file.Name(), "_test.go"),
+		SyntaxOnly: true,
+	}
+	for _, imp := range pkg.fileImps[file.Pos(0)] {
+		if imp.Name != "_" {
+			iface.Imports = append(iface.Imports, imp)
+		}
+	}
+	if spec.TypeParams != nil {
+		for _, field := range spec.TypeParams.List {
+			for _, name := range field.Names {
+				iface.TypeParams = append(iface.TypeParams, TypeParam{
+					Name:       name.Name,
+					Constraint: types.ExprString(field.Type),
+				})
+			}
+		}
+	}
+
+	methods, err := syntaxMethods(pkg, ifaceType, map[string]bool{spec.Name.Name: true})
+	if err != nil {
+		return Interface{}, err
+	}
+	seen := map[string]bool{}
+	for _, method := range methods {
+		// The same method can be embedded more than once
+		if !seen[method.Name] {
+			seen[method.Name] = true
+			iface.Methods = append(iface.Methods, method)
+		}
+	}
+	return iface, nil
+}
+
+// syntaxMethods returns the methods of the interface type, including those
+// of the interfaces it embeds (other than those already visited), in the
+// order they're declared in.
+func syntaxMethods(pkg *loadedPackage, ifaceType *ast.InterfaceType, visited map[string]bool) ([]Method, error) {
+	var methods []Method
+	for _, field := range ifaceType.Methods.List {
+		position := pkg.Fset.Position(field.Type.Pos())
+
+		// Embedded interfaces
+		if len(field.Names) == 0 {
+			switch field.Type.(type) {
+			case *ast.UnaryExpr, *ast.BinaryExpr:
+				return nil, &Diagnostic{Position: position, Message: "the interface is a type constraint, which can't be implemented"}
+			}
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok {
+				return nil, &Diagnostic{Position: position, Message: fmt.Sprintf("can't find the methods of embedded %s without type checking", types.ExprString(field.Type))}
+			}
+			if visited[ident.Name] {
+				continue
+			}
+			visited[ident.Name] = true
+			spec := pkg.lookupSpec(ident.Name)
+			if spec == nil {
+				return nil, &Diagnostic{Position: position, Message: fmt.Sprintf("can't find the methods of embedded %s without type checking", ident.Name)}
+			}
+			embeddedType, ok := spec.Type.(*ast.InterfaceType)
+			if !ok || spec.TypeParams != nil {
+				return nil, &Diagnostic{Position: position, Message: fmt.Sprintf("can't find the methods of embedded %s without type checking", ident.Name)}
+			}
+			embedded, err := syntaxMethods(pkg, embeddedType, visited)
+			if err != nil {
+				return nil, err
+			}
+			for i := range embedded {
+				if embedded[i].Embedded == "" {
+					embedded[i].Embedded = ident.Name
+				}
+			}
+			methods = append(methods, embedded...)
+			continue
+		}
+
+		sig, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, &Diagnostic{Position: position, Message: fmt.Sprintf("%s is not a method signature", field.Names[0].Name)}
+		}
+		method := Method{
+			Name:     field.Names[0].Name,
+			Doc:      pkg.docs[field.Names[0].Pos()],
+			Position: pkg.Fset.Position(field.Names[0].Pos()),
+			pos:      field.Names[0].Pos(),
+		}
+		if !field.Names[0].IsExported() {
+			method.PkgPath = pkg.PkgPath
+		}
+		for _, param := range syntaxFields(sig.Params) {
+			// Variadic params' types are slices, as in their signature's type
+			typ, variadic := param.Type, false
+			if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = &ast.ArrayType{Elt: ellipsis.Elt}, true
+			}
+			method.Params = append(method.Params, Param{
+				Name:     param.Name,
+				Type:     types.ExprString(typ),
+				Variadic: variadic,
+			})
+		}
+		for _, result := range syntaxFields(sig.Results) {
+			method.Results = append(method.Results, Result{
+				Name: result.Name,
+				Type: types.ExprString(result.Type),
+			})
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
+
+// syntaxField is a single param or result in a field list.
+type syntaxField struct {
+	Name string
+	Type ast.Expr
+}
+
+// syntaxFields expands the field list (which may be nil) into its params or
+// results, one per name (or one per field, for unnamed fields).
+func syntaxFields(list *ast.FieldList) []syntaxField {
+	if list == nil {
+		return nil
+	}
+	var fields []syntaxField
+	for _, field := range list.List {
+		if len(field.Names) == 0 {
+			fields = append(fields, syntaxField{Type: field.Type})
+		}
+		for _, name := range field.Names {
+			fields = append(fields, syntaxField{Name: name.Name, Type: field.Type})
+		}
+	}
+	return fields
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"text/template"
 
 	"github.com/nathanjcochran/mock/iface"
@@ -13,60 +16,197 @@ import (
 )
 
 func main() {
-	var (
-		dir     = flag.String("d", ".", "Directory to search for interface in")
-		outFile = flag.String("o", "", "Output file (default stdout)")
-	)
+	// The migrate subcommand translates other generators' directives
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(os.Args[2:])
+		return
+	}
+
+	var opts options
+	opts.register(flag.CommandLine)
+	watchOn := flag.Bool("watch", false, "Watch the packages given as arguments (default ./...), regenerating the output of their go:generate directives as their interfaces change")
+	generateOn := flag.Bool("generate", false, "Run the go:generate directives in the packages given as arguments (default ./...) in parallel, reporting all of their errors")
+	jobs := flag.Int("j", runtime.GOMAXPROCS(0), "Maximum number of packages to load or interfaces to generate at once, with -generate or -watch")
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] interface\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s -generate [-j n] [packages]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s -watch [-j n] [packages]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s migrate [-w] [packages]\n", os.Args[0])
 		fmt.Fprintf(flag.CommandLine.Output(), "Options:\n")
 		flag.PrintDefaults()
 	}
 	flag.Parse()
 
+	if *generateOn {
+		generateAll(flag.Args(), *jobs)
+		return
+	}
+	if *watchOn {
+		watch(flag.Args(), *jobs)
+		return
+	}
+
 	// First argument is interface name
 	args := flag.Args()
 	if len(args) < 1 {
-		log.Fatal("Not enough args")
+		fatal(opts.jsonOut, "Not enough args", nil)
 	}
 	ifaceName := args[0]
 
 	// Parse the package and get info about the interface
-	iface, err := iface.GetInterface(*dir, ifaceName)
+	loader, err := iface.NewLoader(opts.config(), opts.dir)
+	if err != nil {
+		fatal(opts.jsonOut, "Error getting interface information", err)
+	}
+	iface, err := loader.Interface(ifaceName)
 	if err != nil {
-		log.Fatalf("Error getting interface information: %s", err)
+		fatal(opts.jsonOut, "Error getting interface information", err)
+	}
+	if iface.SyntaxOnly && !opts.jsonOut {
+		log.Printf("Warning: %s has type errors, so it was generated from its syntax alone", ifaceName)
 	}
+	outFile := outputFile(iface, opts.outFile)
 
-	// Parse the template
-	tmpl, err := template.New("default").Parse(tmpl)
+	// Skip the unchanged work of regenerating an up-to-date
+	// output file, which also leaves its modification time be
+	if outFile != "" && !opts.force && upToDate(outFile, iface, opts.styleName, opts.naming) {
+		return
+	}
+
+	// Generate the implementation
+	formatted, err := generate(iface, opts.styleName, opts.naming, outFile)
 	if err != nil {
-		log.Fatalf("Error parsing template: %s", err)
+		fatal(opts.jsonOut, "Error generating "+opts.styleName, err)
 	}
 
-	// Execute/output the template
-	buf := &bytes.Buffer{}
-	if err := tmpl.Execute(buf, &iface); err != nil {
-		log.Fatalf("Error executing template: %s", err)
+	// Write the formatted output to the file, if provided, or stdout
+	if outFile == "" {
+		if _, err := os.Stdout.Write(formatted); err != nil {
+			fatal(opts.jsonOut, "Error writing output", err)
+		}
+		return
+	}
+	if _, err := writeIfChanged(outFile, formatted); err != nil {
+		fatal(opts.jsonOut, "Error writing output file", err)
 	}
+}
 
-	// Format it with go imports
-	formatted, err := imports.Process(*outFile, buf.Bytes(), nil)
+// options are the flags controlling how an implementation of an interface
+// is generated, whether on the command line or in a go:generate directive.
+type options struct {
+	dir       string
+	outFile   string
+	tests     bool
+	syntax    bool
+	styleName string
+	naming    naming
+	force     bool
+	jsonOut   bool
+}
+
+// register defines the flags that set the options.
+func (o *options) register(flags *flag.FlagSet) {
+	flags.StringVar(&o.dir, "d", ".", "Directory to search for interface in")
+	flags.StringVar(&o.outFile, "o", "", "Output file (default stdout)")
+	flags.BoolVar(&o.tests, "tests", false, "Also search _test.go files for the interface")
+	flags.BoolVar(&o.syntax, "syntax", false, "If the interface has type errors, generate from its syntax alone")
+	flags.StringVar(&o.styleName, "style", "mock", "Style of implementation to generate: mock, fake, logging, metrics, tracing, record, gomock, moq or counterfeiter")
+	o.naming.register(flags)
+	flags.BoolVar(&o.force, "force", false, "Regenerate the output file even if its fingerprint shows it's up to date")
+	flags.BoolVar(&o.jsonOut, "json", false, "Report errors to stderr as JSON diagnostics, for editor integration")
+}
+
+// config returns the configuration for loading the interface's package.
+func (o *options) config() iface.Config {
+	return iface.Config{Tests: o.tests, SyntaxFallback: o.syntax}
+}
+
+// outputFile returns the file that the implementation of the interface
+// should be written to, given the one provided with -o (if any). A mock of
+// an interface declared in a _test.go file can only be compiled as part of
+// a _test.go file, so the suffix is added if it's missing.
+func outputFile(iface iface.Interface, outFile string) string {
+	if iface.Test && outFile != "" && !strings.HasSuffix(outFile, "_test.go") {
+		return strings.TrimSuffix(outFile, ".go") + "_test.go"
+	}
+	return outFile
+}
+
+// checkUnexported returns an error if the interface has unexported methods
+// that the implementation written to the output file (if any) can't
+// implement. Unexported methods can only be implemented in the package they
+// belong to, so the output file must be in the interface's own directory, and
+// the interface can't embed another package's unexported methods.
+func checkUnexported(i iface.Interface, outFile string) error {
+	for _, m := range i.Methods {
+		if m.PkgPath == "" {
+			continue
+		}
+		if m.PkgPath != i.PkgPath {
+			return &iface.Diagnostic{
+				Position: i.Position,
+				Message: fmt.Sprintf("%s embeds the unexported method %s of %s, which can only be implemented in package %s",
+					i.Name, m.Name, m.Embedded, m.PkgPath),
+			}
+		}
+		if outFile == "" {
+			continue
+		}
+		outDir, err := filepath.Abs(filepath.Dir(outFile))
+		if err != nil {
+			return err
+		}
+		if ifaceDir := filepath.Dir(i.Position.Filename); outDir != ifaceDir {
+			return &iface.Diagnostic{
+				Position: m.Position,
+				Message: fmt.Sprintf("%s has the unexported method %s, so it can only be implemented in package %s, but the output file is in %s",
+					i.Name, m.Name, i.PkgPath, outDir),
+			}
+		}
+	}
+	return nil
+}
+
+// generate executes the template of the given style for the interface, with
+// the given naming, and formats the output with goimports. The name of the
+// output file (if any) is used to resolve any missing imports. The output
+// starts with a header recording its fingerprint.
+func generate(iface iface.Interface, styleName string, naming naming, outFile string) ([]byte, error) {
+	// Parse the template
+	style, ok := styles[styleName]
+	if !ok {
+		return nil, fmt.Errorf("unknown style: %s", styleName)
+	}
+	if err := checkUnexported(iface, outFile); err != nil {
+		return nil, err
+	}
+	if err := naming.check(style, styleName, iface); err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(styleName).Funcs(funcs).Funcs(naming.funcs(style, iface.Name)).Funcs(packageFuncs(iface)).Parse(style.tmpl)
 	if err != nil {
-		log.Fatalf("Error formating output: %s", err)
+		return nil, fmt.Errorf("error parsing template: %w", err)
 	}
 
-	// Open the file, if provided, or use stdout
-	out := os.Stdout
-	if *outFile != "" {
-		out, err = os.Create(*outFile)
+	// Get the data to execute the template with
+	var data any = &iface
+	if style.data != nil {
+		data, err = style.data(iface)
 		if err != nil {
-			log.Fatalf("Error creating output file: %s", err)
+			return nil, err
 		}
-		defer out.Close()
 	}
 
-	// Write the formatted output to the file
-	if _, err := out.Write(formatted); err != nil {
-		log.Fatalf("Error writing to file: %s", err)
+	// Execute/output the template
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	// Format it with go imports
+	formatted, err := imports.Process(outFile, buf.Bytes(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting output: %w", err)
 	}
+	return append([]byte(header(fingerprint(iface, styleName, naming, outFile))), formatted...), nil
 }
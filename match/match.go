@@ -0,0 +1,359 @@
+// Package match provides composable argument matchers for use with the
+// expectation (OnXxx) and assertion (AssertXxxCalledWith) methods of
+// generated mocks.
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/nathanjcochran/mock/diff"
+)
+
+// Matcher matches a single argument.
+type Matcher interface {
+	// Matches reports whether the argument matches.
+	Matches(arg any) bool
+
+	// String describes the arguments that match.
+	String() string
+}
+
+// Explainer is implemented by matchers that can explain
+// in detail why an argument doesn't match.
+type Explainer interface {
+	// Explain describes why the argument doesn't match.
+	Explain(arg any) string
+}
+
+// Explain describes why an argument doesn't match a matcher, using
+// the matcher's Explain method if it has one.
+func Explain(m Matcher, arg any) string {
+	if e, ok := m.(Explainer); ok {
+		if explanation := e.Explain(arg); explanation != "" {
+			return explanation
+		}
+	}
+	return fmt.Sprintf("want %s, got %s", m, diff.Format(arg))
+}
+
+// matcher implements Matcher with a function.
+type matcher struct {
+	match   func(arg any) bool
+	desc    string
+	explain func(arg any) string
+}
+
+func (m *matcher) Matches(arg any) bool { return m.match(arg) }
+func (m *matcher) String() string       { return m.desc }
+
+func (m *matcher) Explain(arg any) string {
+	if m.explain == nil {
+		return ""
+	}
+	return m.explain(arg)
+}
+
+func newMatcher(desc string, match func(arg any) bool) Matcher {
+	return &matcher{match: match, desc: desc}
+}
+
+// explainDiff explains mismatches with a structural diff against v.
+func explainDiff(v any) func(arg any) string {
+	return func(arg any) string {
+		return diff.Diff(v, arg)
+	}
+}
+
+// Of returns v if it is a Matcher, or a DeepEq matcher for it otherwise. It
+// is used by generated mocks, so that plain values can be passed in place
+// of matchers.
+func Of(v any) Matcher {
+	if m, ok := v.(Matcher); ok {
+		return m
+	}
+	return DeepEq(v)
+}
+
+// OfType is like Of, but converts a plain value to T, the type of the
+// argument it is matched against, if that is lossless. This way, constants
+// (e.g. 1 for an int64 argument, or "x" for an argument of a named string
+// type) and nil (for an argument of a pointer, slice, map, channel or
+// function type) match the arguments equal to them. It is used by
+// generated mocks.
+func OfType[T any](v any) Matcher {
+	if m, ok := v.(Matcher); ok {
+		return m
+	}
+	return DeepEq(convert[T](v))
+}
+
+// convert returns v converted to T, if it is nil and T is nillable, if it is
+// assignable to T, or if it is a number, string or bool that converts to T
+// and back without loss. Otherwise, it returns v.
+func convert[T any](v any) any {
+	t := reflect.TypeFor[T]()
+	if v == nil {
+		if isNil(reflect.Zero(t).Interface()) {
+			return reflect.Zero(t).Interface()
+		}
+		return v
+	}
+	val := reflect.ValueOf(v)
+	switch {
+	case val.Type() == t || t.Kind() == reflect.Interface:
+		return v
+	case val.Type().AssignableTo(t):
+		return val.Convert(t).Interface()
+	case kindClass(val.Kind()) == "" || kindClass(val.Kind()) != kindClass(t.Kind()) || !val.CanConvert(t):
+		return v
+	}
+	converted := val.Convert(t)
+	if !converted.Convert(val.Type()).Equal(val) || negative(converted) != negative(val) {
+		return v
+	}
+	return converted.Interface()
+}
+
+// negative reports whether v is a negative number. (Converting between
+// signed and unsigned integers of the same size changes the sign of some
+// values, but they convert back without loss.)
+func negative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	}
+	return false
+}
+
+// kindClass returns the class of kinds of values that can be converted to
+// each other as values (rather than, e.g., from an integer to a string).
+func kindClass(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Complex64, reflect.Complex128:
+		return "complex"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	}
+	return ""
+}
+
+// Any matches any argument.
+func Any() Matcher {
+	return newMatcher("any value", func(any) bool { return true })
+}
+
+// Nil matches nil, including nil pointers, slices,
+// maps, channels, functions and interfaces.
+func Nil() Matcher {
+	return newMatcher("nil", isNil)
+}
+
+func isNil(arg any) bool {
+	if arg == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(arg); v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// Eq matches arguments equal to v, as compared with ==.
+// Arguments of a different type than v never match.
+func Eq(v any) Matcher {
+	return &matcher{
+		desc: fmt.Sprintf("== %s", diff.Format(v)),
+		match: func(arg any) bool {
+			argVal, val := reflect.ValueOf(arg), reflect.ValueOf(v)
+			if !argVal.IsValid() || !val.IsValid() {
+				return argVal.IsValid() == val.IsValid()
+			}
+			if argVal.Type() != val.Type() || !val.Comparable() {
+				return false
+			}
+			return val.Equal(argVal)
+		},
+		explain: explainDiff(v),
+	}
+}
+
+// DeepEq matches arguments deeply equal to v, as
+// compared with reflect.DeepEqual.
+func DeepEq(v any) Matcher {
+	return &matcher{
+		desc: fmt.Sprintf("deeply equal to %s", diff.Format(v)),
+		match: func(arg any) bool {
+			return reflect.DeepEqual(arg, v)
+		},
+		explain: explainDiff(v),
+	}
+}
+
+// Regexp matches strings, byte slices and fmt.Stringers
+// that contain a match of the regular expression.
+// It panics if the expression can't be parsed.
+func Regexp(expr string) Matcher {
+	re := regexp.MustCompile(expr)
+	return newMatcher(fmt.Sprintf("matching /%s/", expr), func(arg any) bool {
+		str, ok := stringOf(arg)
+		return ok && re.MatchString(str)
+	})
+}
+
+// HasPrefix matches strings, byte slices and fmt.Stringers
+// that begin with prefix.
+func HasPrefix(prefix string) Matcher {
+	return newMatcher(fmt.Sprintf("with prefix %q", prefix), func(arg any) bool {
+		str, ok := stringOf(arg)
+		return ok && strings.HasPrefix(str, prefix)
+	})
+}
+
+// HasSuffix matches strings, byte slices and fmt.Stringers
+// that end with suffix.
+func HasSuffix(suffix string) Matcher {
+	return newMatcher(fmt.Sprintf("with suffix %q", suffix), func(arg any) bool {
+		str, ok := stringOf(arg)
+		return ok && strings.HasSuffix(str, suffix)
+	})
+}
+
+func stringOf(arg any) (string, bool) {
+	switch v := arg.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	if v := reflect.ValueOf(arg); v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return "", false
+}
+
+// Len matches strings, slices, arrays, maps and
+// channels of length n.
+func Len(n int) Matcher {
+	return newMatcher(fmt.Sprintf("with length %d", n), func(arg any) bool {
+		switch v := reflect.ValueOf(arg); v.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			return v.Len() == n
+		}
+		return false
+	})
+}
+
+// Contains matches strings containing the substring v, slices and arrays
+// containing an element deeply equal to v, and maps containing the key v.
+// If v is a Matcher, slices and arrays match if any element matches, and
+// maps match if any key matches.
+func Contains(v any) Matcher {
+	elem := Of(v)
+	return newMatcher(fmt.Sprintf("containing %s", describe(v)), func(arg any) bool {
+		if substr, ok := v.(string); ok {
+			if str, ok := stringOf(arg); ok {
+				return strings.Contains(str, substr)
+			}
+		}
+		switch val := reflect.ValueOf(arg); val.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := range val.Len() {
+				if elem.Matches(val.Index(i).Interface()) {
+					return true
+				}
+			}
+		case reflect.Map:
+			for _, key := range val.MapKeys() {
+				if elem.Matches(key.Interface()) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// Func matches arguments of type T for which pred returns true.
+func Func[T any](pred func(T) bool) Matcher {
+	return newMatcher(fmt.Sprintf("satisfying a func(%s) bool", reflect.TypeFor[T]()), func(arg any) bool {
+		v, ok := arg.(T)
+		if !ok && arg == nil {
+			// A nil interface value (e.g. a nil error) is passed
+			// as an untyped nil, which doesn't assert to T
+			ok = reflect.TypeFor[T]().Kind() == reflect.Interface
+		}
+		return ok && pred(v)
+	})
+}
+
+// Not matches arguments that don't match v.
+func Not(v any) Matcher {
+	m := Of(v)
+	return newMatcher(fmt.Sprintf("not %s", m), func(arg any) bool {
+		return !m.Matches(arg)
+	})
+}
+
+// And matches arguments that match all of vs.
+func And(vs ...any) Matcher {
+	ms := allOf(vs)
+	return newMatcher(join(ms, " and "), func(arg any) bool {
+		for _, m := range ms {
+			if !m.Matches(arg) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Or matches arguments that match any of vs.
+func Or(vs ...any) Matcher {
+	ms := allOf(vs)
+	return newMatcher(join(ms, " or "), func(arg any) bool {
+		for _, m := range ms {
+			if m.Matches(arg) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func allOf(vs []any) []Matcher {
+	var ms []Matcher
+	for _, v := range vs {
+		ms = append(ms, Of(v))
+	}
+	return ms
+}
+
+func join(ms []Matcher, sep string) string {
+	var strs []string
+	for _, m := range ms {
+		strs = append(strs, fmt.Sprintf("(%s)", m))
+	}
+	return strings.Join(strs, sep)
+}
+
+// describe describes a value or Matcher.
+func describe(v any) string {
+	if m, ok := v.(Matcher); ok {
+		return m.String()
+	}
+	return diff.Format(v)
+}
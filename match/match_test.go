@@ -0,0 +1,234 @@
+package match
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type (
+	named      string
+	namedBool  bool
+	namedSlice []int
+)
+
+type stringer struct{ s string }
+
+func (s stringer) String() string { return s.s }
+
+func TestMatchers(t *testing.T) {
+	var nilPtr *int
+	var nilErr error
+	tests := []struct {
+		name    string
+		matcher Matcher
+		arg     any
+		want    bool
+	}{
+		{"Any", Any(), 1, true},
+		{"Any nil", Any(), nil, true},
+
+		{"Nil", Nil(), nil, true},
+		{"Nil pointer", Nil(), nilPtr, true},
+		{"Nil slice", Nil(), []int(nil), true},
+		{"Nil empty slice", Nil(), []int{}, false},
+		{"Nil zero", Nil(), 0, false},
+
+		{"Eq", Eq(1), 1, true},
+		{"Eq unequal", Eq(1), 2, false},
+		{"Eq other type", Eq(1), int64(1), false},
+		{"Eq nil", Eq(nil), nil, true},
+		{"Eq nil value", Eq(nil), 0, false},
+		{"Eq incomparable", Eq([]int{1}), []int{1}, false},
+
+		{"DeepEq", DeepEq([]int{1, 2}), []int{1, 2}, true},
+		{"DeepEq unequal", DeepEq([]int{1, 2}), []int{2, 1}, false},
+		{"DeepEq map", DeepEq(map[string]int{"a": 1}), map[string]int{"a": 1}, true},
+
+		{"Regexp", Regexp("^a.c$"), "abc", true},
+		{"Regexp bytes", Regexp("b"), []byte("abc"), true},
+		{"Regexp stringer", Regexp("b"), stringer{"abc"}, true},
+		{"Regexp named", Regexp("b"), named("abc"), true},
+		{"Regexp mismatch", Regexp("^b"), "abc", false},
+		{"Regexp not string", Regexp("1"), 1, false},
+
+		{"HasPrefix", HasPrefix("ab"), "abc", true},
+		{"HasPrefix mismatch", HasPrefix("bc"), "abc", false},
+		{"HasSuffix", HasSuffix("bc"), "abc", true},
+		{"HasSuffix mismatch", HasSuffix("ab"), "abc", false},
+
+		{"Len string", Len(3), "abc", true},
+		{"Len slice", Len(2), []int{1, 2}, true},
+		{"Len map", Len(1), map[int]int{1: 1}, true},
+		{"Len mismatch", Len(2), []int{1}, false},
+		{"Len not sized", Len(0), 0, false},
+
+		{"Contains substring", Contains("b"), "abc", true},
+		{"Contains element", Contains(2), []int{1, 2}, true},
+		{"Contains array element", Contains(2), [2]int{1, 2}, true},
+		{"Contains key", Contains("a"), map[string]int{"a": 1}, true},
+		{"Contains matcher", Contains(HasPrefix("b")), []string{"a", "bc"}, true},
+		{"Contains mismatch", Contains(3), []int{1, 2}, false},
+		{"Contains not container", Contains(1), 1, false},
+
+		{"Func", Func(func(n int) bool { return n > 1 }), 2, true},
+		{"Func false", Func(func(n int) bool { return n > 1 }), 1, false},
+		{"Func other type", Func(func(n int) bool { return true }), "a", false},
+		{"Func nil interface", Func(func(err error) bool { return err == nil }), nilErr, true},
+		{"Func nil non-interface", Func(func(n *int) bool { return true }), nil, false},
+
+		{"Not", Not(1), 2, true},
+		{"Not match", Not(1), 1, false},
+		{"Not matcher", Not(Nil()), 1, true},
+
+		{"And", And(HasPrefix("a"), HasSuffix("c")), "abc", true},
+		{"And one mismatch", And(HasPrefix("a"), HasSuffix("b")), "abc", false},
+		{"And none", And(), 1, true},
+		{"Or", Or(1, 2), 2, true},
+		{"Or mismatch", Or(1, 2), 3, false},
+		{"Or none", Or(), 1, false},
+		{"Nested", Or(And(Len(3), HasPrefix("a")), Nil()), "abc", true},
+		{"Nested nil", Or(And(Len(3), HasPrefix("a")), Nil()), nil, true},
+		{"Nested mismatch", Or(And(Len(3), HasPrefix("a")), Nil()), "abcd", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.arg); got != tt.want {
+				t.Errorf("%s: Matches(%#v) = %t, want %t", tt.matcher, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcherString(t *testing.T) {
+	tests := []struct {
+		matcher Matcher
+		want    string
+	}{
+		{Any(), "any value"},
+		{Nil(), "nil"},
+		{Eq(1), "== 1"},
+		{Regexp("^a"), "matching /^a/"},
+		{HasPrefix("a"), `with prefix "a"`},
+		{Len(2), "with length 2"},
+		{Contains(HasPrefix("a")), `containing with prefix "a"`},
+		{Func(func(int) bool { return true }), "satisfying a func(int) bool"},
+		{Not(Nil()), "not nil"},
+		{And(Nil(), Len(0)), "(nil) and (with length 0)"},
+		{Or(Nil(), Len(0)), "(nil) or (with length 0)"},
+	}
+	for _, tt := range tests {
+		if got := tt.matcher.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	m := HasPrefix("a")
+	if got := Of(m); got != m {
+		t.Errorf("Of(matcher) = %s, want the matcher", got)
+	}
+	if !Of([]int{1}).Matches([]int{1}) {
+		t.Errorf("Of([]int{1}) doesn't match []int{1}")
+	}
+}
+
+func TestOfType(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher Matcher
+		arg     any
+		want    bool
+	}{
+		{"matcher", OfType[int64](Any()), int64(1), true},
+		{"same type", OfType[int64](int64(1)), int64(1), true},
+		{"untyped constant", OfType[int64](1), int64(1), true},
+		{"unequal constant", OfType[int64](2), int64(1), false},
+		{"float constant", OfType[float64](1), 1.0, true},
+		{"named string", OfType[named]("a"), named("a"), true},
+		{"named duration", OfType[time.Duration](5), 5 * time.Nanosecond, true},
+		{"nil pointer", OfType[*int](nil), (*int)(nil), true},
+		{"nil slice", OfType[[]int](nil), []int(nil), true},
+		{"nil func", OfType[func()](nil), (func())(nil), true},
+		{"nil interface", OfType[error](nil), nil, true},
+		{"interface", OfType[error](errors.ErrUnsupported), errors.ErrUnsupported, true},
+		{"assignable", OfType[namedSlice]([]int{1}), namedSlice{1}, true},
+
+		// Lossy conversions aren't made
+		{"overflow", OfType[int8](300), int8(44), false},
+		{"fraction", OfType[int](1.5), 1, false},
+		{"negative unsigned", OfType[uint](-1), ^uint(0), false},
+		{"large signed", OfType[int64](^uint64(0)), int64(-1), false},
+		{"int to string", OfType[string](65), "A", false},
+		{"nil non-nillable", OfType[int](nil), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.arg); got != tt.want {
+				t.Errorf("%s: Matches(%#v) = %t, want %t", tt.matcher, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"int to int64", convert[int64](1), int64(1)},
+		{"int to float32", convert[float32](2), float32(2)},
+		{"float to int", convert[int](2.0), 2},
+		{"string to named", convert[named]("a"), named("a")},
+		{"bool to named", convert[namedBool](true), namedBool(true)},
+		{"complex", convert[complex64](complex(1, 2)), complex64(complex(1, 2))},
+		{"nil to slice", convert[[]int](nil), []int(nil)},
+		{"nil to int", convert[int](nil), nil},
+		{"to interface", convert[any](1), 1},
+		{"lossy", convert[int8](300), 300},
+		{"sign change", convert[uint](-1), -1},
+		{"other class", convert[string](65), 65},
+		{"inconvertible", convert[int]("1"), "1"},
+	}
+	for _, tt := range tests {
+		if !DeepEq(tt.want).Matches(tt.got) {
+			t.Errorf("%s: got %#v, want %#v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestNotCalledReport(t *testing.T) {
+	params := []string{"id", "n"}
+	matchers := []Matcher{Eq("a"), Any()}
+	got := NotCalledReport("Get", params, matchers, []Call{{"b", 1}, {"a", 2}})
+	for _, want := range []string{
+		`want: Get(id: == "a", n: any value)`,
+		"Get was called 2 time(s):",
+		`#1: Get(id: "b", n: 1)`,
+		`#2: Get(id: "a", n: 2)`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, got)
+		}
+	}
+	if got := NotCalledReport("Get", params, matchers, nil); !strings.HasSuffix(got, "Get was never called") {
+		t.Errorf("report of a method that was never called:\n%s", got)
+	}
+}
+
+func TestUnexpectedCallReport(t *testing.T) {
+	got := UnexpectedCallReport("Get", []string{"id"}, Call{"b"}, [][]Matcher{{Eq("a")}, {HasPrefix("c")}})
+	for _, want := range []string{
+		`unexpected call: Get(id: "b")`,
+		"Get has 2 expectation(s):",
+		`#1: Get(id: == "a")`,
+		`#2: Get(id: with prefix "c")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, got)
+		}
+	}
+}
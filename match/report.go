@@ -0,0 +1,88 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nathanjcochran/mock/diff"
+)
+
+// Call is the arguments of a call made to a mocked method,
+// in order, for use in failure reports.
+type Call []any
+
+// NotCalledReport describes the failure of an assertion that a method
+// was called with arguments matching the given matchers: the matchers,
+// followed by every call the method actually received, along with an
+// explanation of why each of its arguments didn't match. The params
+// are the names of the method's parameters. It is used by generated
+// mocks.
+func NotCalledReport(method string, params []string, matchers []Matcher, calls []Call) string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "%s was not called with matching arguments\n", method)
+	fmt.Fprintf(&s, "want: %s(%s)\n", method, describeMatchers(params, matchers))
+	if len(calls) == 0 {
+		fmt.Fprintf(&s, "%s was never called", method)
+		return s.String()
+	}
+	fmt.Fprintf(&s, "%s was called %d time(s):", method, len(calls))
+	for i, call := range calls {
+		fmt.Fprintf(&s, "\n  #%d: %s(%s)", i+1, method, describeCall(params, call))
+		writeMismatches(&s, params, matchers, call)
+	}
+	return s.String()
+}
+
+// UnexpectedCallReport describes a call that didn't match any of the
+// expectations registered for a method: the call, followed by an
+// explanation of why it didn't match each expectation. The params are
+// the names of the method's parameters. It is used by generated mocks.
+func UnexpectedCallReport(method string, params []string, call Call, expectations [][]Matcher) string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "unexpected call: %s(%s)\n", method, describeCall(params, call))
+	fmt.Fprintf(&s, "%s has %d expectation(s):", method, len(expectations))
+	for i, matchers := range expectations {
+		fmt.Fprintf(&s, "\n  #%d: %s(%s)", i+1, method, describeMatchers(params, matchers))
+		writeMismatches(&s, params, matchers, call)
+	}
+	return s.String()
+}
+
+// Describe describes an expectation registered for a method, e.g.
+// `Get(id: "a")`. The params are the names of the method's parameters.
+// It is used by generated mocks.
+func Describe(method string, params []string, matchers []Matcher) string {
+	return fmt.Sprintf("%s(%s)", method, describeMatchers(params, matchers))
+}
+
+func describeMatchers(params []string, matchers []Matcher) string {
+	var strs []string
+	for i, m := range matchers {
+		strs = append(strs, fmt.Sprintf("%s: %s", params[i], m))
+	}
+	return strings.Join(strs, ", ")
+}
+
+func describeCall(params []string, call Call) string {
+	var strs []string
+	for i, arg := range call {
+		strs = append(strs, fmt.Sprintf("%s: %s", params[i], diff.Format(arg)))
+	}
+	return strings.Join(strs, ", ")
+}
+
+// writeMismatches explains why each mismatched argument of
+// the call didn't match, indenting multi-line explanations.
+func writeMismatches(s *strings.Builder, params []string, matchers []Matcher, call Call) {
+	for i, m := range matchers {
+		if m.Matches(call[i]) {
+			continue
+		}
+		explanation := Explain(m, call[i])
+		if strings.Contains(explanation, "\n") {
+			fmt.Fprintf(s, "\n      %s:\n        %s", params[i], strings.ReplaceAll(explanation, "\n", "\n        "))
+		} else {
+			fmt.Fprintf(s, "\n      %s: %s", params[i], explanation)
+		}
+	}
+}
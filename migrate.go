@@ -0,0 +1,854 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/mod/modfile"
+	"gopkg.in/yaml.v3"
+)
+
+// translation is a mockgen directive or mockery configuration entry,
+// along with the equivalent mock directives.
+type translation struct {
+	// Position and text of the original directive or entry
+	pos string
+	old string
+
+	// Equivalent mock directives (empty if it couldn't be translated)
+	new []string
+
+	// Why it couldn't be translated, and differences in
+	// behavior to be aware of if it could
+	problems []string
+	notes    []string
+
+	// File and (1-based) line of a mockgen directive, or directory
+	// of the package the directives of a mockery entry belong in
+	file string
+	line int
+	dir  string
+}
+
+func (t *translation) problem(format string, args ...any) {
+	t.problems = append(t.problems, fmt.Sprintf(format, args...))
+}
+
+func (t *translation) note(format string, args ...any) {
+	t.notes = append(t.notes, fmt.Sprintf(format, args...))
+}
+
+// mockeryGenerateFile is the name of the file the
+// directives translated from mockery configs are written to.
+const mockeryGenerateFile = "mock_generate.go"
+
+// migrate implements the migrate subcommand, which translates the mockgen
+// go:generate directives and mockery configuration files found in the
+// directories matching the given patterns into equivalent mock directives.
+func migrate(args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	write := flags.Bool("w", false, "Rewrite the directives in place (default: only report them)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s migrate [options] [packages]\n", os.Args[0])
+		fmt.Fprintf(flags.Output(), "Options:\n")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	patterns := flags.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	dirs, err := expandPatterns(patterns)
+	if err != nil {
+		log.Fatalf("Error finding packages: %s", err)
+	}
+
+	var translations []translation
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			log.Fatalf("Error reading directory: %s", err)
+		}
+		for _, entry := range entries {
+			file := filepath.Join(dir, entry.Name())
+			switch name := entry.Name(); {
+			case entry.IsDir():
+			case strings.HasSuffix(name, ".go"):
+				t, err := migrateMockgen(file)
+				if err != nil {
+					log.Fatalf("Error reading %s: %s", file, err)
+				}
+				translations = append(translations, t...)
+			case name == ".mockery.yaml" || name == ".mockery.yml":
+				t, err := migrateMockery(file)
+				if err != nil {
+					log.Fatalf("Error reading %s: %s", file, err)
+				}
+				translations = append(translations, t...)
+			}
+		}
+	}
+
+	failed := report(os.Stdout, translations)
+	if *write {
+		if err := rewrite(translations); err != nil {
+			log.Fatalf("Error rewriting directives: %s", err)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "%d of %d directives and config entries can be migrated", len(translations)-failed, len(translations))
+	if !*write && failed < len(translations) {
+		fmt.Fprintf(os.Stderr, " (run with -w to rewrite them)")
+	}
+	fmt.Fprintln(os.Stderr)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// expandPatterns returns the directories matching the patterns, which are
+// either directories or directories followed by "/..." (to also match all
+// of their subdirectories, except for testdata, vendor and hidden ones).
+func expandPatterns(patterns []string) ([]string, error) {
+	var dirs []string
+	for _, pattern := range patterns {
+		root, recursive := strings.CutSuffix(pattern, "/...")
+		if root == "" {
+			root = "."
+		}
+		if !recursive {
+			dirs = append(dirs, filepath.Clean(root))
+			continue
+		}
+		err := filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+			if err != nil || !entry.IsDir() {
+				return err
+			}
+			if name := entry.Name(); path != root &&
+				(name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			dirs = append(dirs, path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// report writes each translation to w, returning the number
+// of directives and entries that couldn't be translated.
+func report(w io.Writer, translations []translation) (failed int) {
+	for _, t := range translations {
+		fmt.Fprintf(w, "%s: %s\n", t.pos, t.old)
+		for _, directive := range t.new {
+			fmt.Fprintf(w, "\t%s\n", directive)
+		}
+		for _, problem := range t.problems {
+			fmt.Fprintf(w, "\tcannot translate: %s\n", problem)
+		}
+		for _, note := range t.notes {
+			fmt.Fprintf(w, "\tnote: %s\n", note)
+		}
+		if len(t.problems) > 0 {
+			failed++
+		}
+	}
+	return failed
+}
+
+// rewrite replaces each translated mockgen directive, and writes the
+// directives translated from mockery configs to a file in each package.
+// Rewritten files keep their permissions.
+func rewrite(translations []translation) error {
+	lines := map[string]map[int][]string{}
+	generate := map[string][]string{}
+	for _, t := range translations {
+		if len(t.problems) > 0 {
+			continue
+		}
+		if t.file != "" {
+			if lines[t.file] == nil {
+				lines[t.file] = map[int][]string{}
+			}
+			lines[t.file][t.line] = t.new
+		} else {
+			generate[t.dir] = append(generate[t.dir], t.new...)
+		}
+	}
+
+	for file, replacements := range lines {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var out []string
+		for i, line := range strings.Split(string(data), "\n") {
+			if directives, ok := replacements[i+1]; ok {
+				out = append(out, directives...)
+				continue
+			}
+			out = append(out, line)
+		}
+		if _, err := writeIfChanged(file, []byte(strings.Join(out, "\n"))); err != nil {
+			return err
+		}
+	}
+
+	for dir, directives := range generate {
+		file := filepath.Join(dir, mockeryGenerateFile)
+		if _, err := os.Stat(file); err == nil {
+			return fmt.Errorf("%s already exists", file)
+		}
+		pkgName, err := packageName(dir)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+		for _, directive := range directives {
+			fmt.Fprintln(&buf, directive)
+		}
+		if _, err := writeIfChanged(file, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mockgenFlags are the flags accepted by mockgen, and whether they're
+// boolean. Flags with no equivalent map to the reason they aren't supported.
+var mockgenFlags = map[string]struct {
+	bool        bool
+	unsupported string
+}{
+	"source":                   {},
+	"destination":              {},
+	"package":                  {},
+	"exclude_interfaces":       {},
+	"self_package":             {},
+	"imports":                  {},
+	"aux_files":                {},
+	"write_package_comment":    {bool: true},
+	"write_source_comment":     {bool: true},
+	"write_generate_directive": {bool: true},
+	"mock_names":               {},
+	"typed":                    {bool: true, unsupported: "typed call wrappers are not supported"},
+	"build_flags":              {unsupported: "build flags are not supported"},
+	"build_constraint":         {unsupported: "build constraints are not supported"},
+	"copyright_file":           {unsupported: "copyright headers are not supported"},
+	"model_gob":                {unsupported: "gob models are not supported"},
+	"exec_only":                {unsupported: "reflect mode programs are not supported"},
+	"prog_only":                {unsupported: "reflect mode programs are not supported"},
+	"debug_parser":             {bool: true},
+}
+
+// migrateMockgen translates the mockgen directives in a Go source file.
+func migrateMockgen(file string) ([]translation, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(data, []byte("mockgen")) {
+		return nil, nil
+	}
+	pkg, err := parser.ParseFile(token.NewFileSet(), file, data, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	var translations []translation
+	for i, line := range strings.Split(string(data), "\n") {
+		args, ok := mockgenArgs(line)
+		if !ok {
+			continue
+		}
+		t := translation{
+			pos:  fmt.Sprintf("%s:%d", file, i+1),
+			old:  strings.TrimSpace(line),
+			file: file,
+			line: i + 1,
+		}
+		translateMockgen(&t, filepath.Dir(file), args, generateEnv(file, i+1, pkg.Name.Name))
+		if len(t.problems) > 0 {
+			t.new = nil
+		}
+		translations = append(translations, t)
+	}
+	return translations, nil
+}
+
+// generateEnv returns the variables that go generate expands in the
+// directive on the given (1-based) line of a file in the named package.
+func generateEnv(file string, line int, pkgName string) func(string) string {
+	return func(name string) string {
+		switch name {
+		case "GOFILE":
+			return filepath.Base(file)
+		case "GOLINE":
+			return strconv.Itoa(line)
+		case "GOPACKAGE":
+			return pkgName
+		case "DOLLAR":
+			return "$"
+		}
+		return os.Getenv(name)
+	}
+}
+
+// mockgenArgs returns the arguments to mockgen in a go:generate directive,
+// which either runs mockgen directly or with "go run".
+func mockgenArgs(line string) ([]string, bool) {
+	directive, ok := strings.CutPrefix(line, "//go:generate ")
+	if !ok {
+		return nil, false
+	}
+	words, err := splitDirective(directive)
+	if err != nil || len(words) == 0 {
+		return nil, false
+	}
+	if len(words) > 2 && words[0] == "go" && words[1] == "run" {
+		pkg, _, _ := strings.Cut(words[2], "@")
+		if path.Base(pkg) == "mockgen" {
+			return words[3:], true
+		}
+		return nil, false
+	}
+	if words[0] == "mockgen" {
+		return words[1:], true
+	}
+	return nil, false
+}
+
+// splitDirective splits a go:generate directive into words, the way go
+// generate does: words are separated by spaces and tabs, unless they're
+// double-quoted Go strings.
+func splitDirective(directive string) ([]string, error) {
+	var words []string
+	for {
+		directive = strings.TrimLeft(directive, " \t")
+		if directive == "" {
+			return words, nil
+		}
+		if directive[0] == '"' {
+			end := 1
+			for ; end < len(directive); end++ {
+				if directive[end] == '\\' {
+					end++
+				} else if directive[end] == '"' {
+					break
+				}
+			}
+			if end >= len(directive) {
+				return nil, fmt.Errorf("unterminated quoted string")
+			}
+			word, err := strconv.Unquote(directive[:end+1])
+			if err != nil {
+				return nil, err
+			}
+			words = append(words, word)
+			directive = directive[end+1:]
+			continue
+		}
+		end := strings.IndexAny(directive, " \t")
+		if end < 0 {
+			end = len(directive)
+		}
+		words = append(words, directive[:end])
+		directive = directive[end:]
+	}
+}
+
+// translateMockgen translates the arguments to a mockgen directive
+// in dir into equivalent mock directives.
+func translateMockgen(t *translation, dir string, args []string, env func(string) string) {
+	// Parse the flags the way mockgen would
+	flags := flag.NewFlagSet("mockgen", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	values := map[string]*string{}
+	for name, f := range mockgenFlags {
+		if f.bool {
+			flags.Bool(name, false, "")
+		} else {
+			values[name] = flags.String(name, "", "")
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		t.problem("%s", err)
+		return
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if reason := mockgenFlags[f.Name].unsupported; reason != "" {
+			t.problem("-%s: %s", f.Name, reason)
+		}
+	})
+	if len(t.problems) > 0 {
+		return
+	}
+
+	var (
+		source      = os.Expand(*values["source"], env)
+		destination = *values["destination"]
+		ifaceDir    string
+		ifaceNames  []string
+		tests       bool
+	)
+	switch {
+	case source != "":
+		// Source mode mocks every interface declared in the source file
+		if flags.NArg() > 0 {
+			t.problem("unexpected arguments in source mode: %s", strings.Join(flags.Args(), " "))
+			return
+		}
+		ifaceDir = filepath.Join(dir, filepath.Dir(source))
+		names, err := declaredInterfaces(filepath.Join(dir, source))
+		if err != nil {
+			t.problem("error reading source file: %s", err)
+			return
+		}
+		ifaceNames = names
+		tests = strings.HasSuffix(source, "_test.go")
+
+	case flags.NArg() == 2:
+		// Reflect mode mocks the listed interfaces of an imported package
+		pkgDir, err := importDir(dir, flags.Arg(0))
+		if err != nil {
+			t.problem("%s", err)
+			return
+		}
+		ifaceDir = pkgDir
+		ifaceNames = strings.Split(flags.Arg(1), ",")
+
+	default:
+		t.problem("expected -source, or an import path and interface names")
+		return
+	}
+
+	if excluded := *values["exclude_interfaces"]; excluded != "" {
+		var names []string
+		for _, name := range ifaceNames {
+			if !slices.Contains(strings.Split(excluded, ","), name) {
+				names = append(names, name)
+			}
+		}
+		ifaceNames = names
+	}
+	if len(ifaceNames) == 0 {
+		t.problem("no interfaces to mock")
+		return
+	}
+
+	// Mocks are always generated into the interface's own package
+	ifacePkg, err := packageName(ifaceDir)
+	if err != nil {
+		t.problem("%s", err)
+		return
+	}
+	if destination != "" {
+		// The destination can refer to $GOFILE etc., but its expansion is
+		// needed to derive the names of the files the mocks are split into
+		destination = os.Expand(destination, env)
+		if len(ifaceNames) > 1 {
+			t.note("each mock will be generated into its own file; remove %s", destination)
+		}
+		if destDir := filepath.Join(dir, filepath.Dir(destination)); destDir != ifaceDir {
+			t.note("the mocks will be generated into package %s instead of %s; "+
+				"update the tests that use them", ifacePkg, destDir)
+			destination = relPath(dir, filepath.Join(ifaceDir, filepath.Base(destination)))
+		}
+	} else if pkgName := *values["package"]; pkgName != "" && pkgName != ifacePkg && pkgName+"_test" != ifacePkg {
+		t.note("the mocks will be generated into package %s instead of %s; "+
+			"update the tests that use them", ifacePkg, pkgName)
+	}
+
+	// Mock names are given as a comma-separated list of Interface=Name pairs
+	mockNames := map[string]string{}
+	if names := *values["mock_names"]; names != "" {
+		for _, pair := range strings.Split(names, ",") {
+			ifaceName, mockName, ok := strings.Cut(pair, "=")
+			if !ok {
+				t.problem("-mock_names: expected Interface=Name pairs: %s", pair)
+				return
+			}
+			mockNames[ifaceName] = mockName
+		}
+	}
+
+	for _, name := range ifaceNames {
+		args := []string{"mock", "-style=gomock"}
+		if mockName := mockNames[name]; mockName != "" {
+			args = append(args, "-name", mockName)
+		}
+		if tests {
+			args = append(args, "-tests")
+		}
+		if rel := relPath(dir, ifaceDir); rel != "." {
+			args = append(args, "-d", rel)
+		}
+		if destination != "" {
+			out := destination
+			if len(ifaceNames) > 1 {
+				out = strings.TrimSuffix(destination, ".go") + "_" + snakeCase(name) + ".go"
+			}
+			args = append(args, "-o", out)
+		}
+		args = append(args, name)
+		t.new = append(t.new, generateDirective(args))
+	}
+}
+
+// mockeryConfig is a mockery (v2) configuration file, whose settings
+// apply to every package, unless overridden by a package's config.
+type mockeryConfig struct {
+	mockeryOptions `yaml:",inline"`
+
+	// Legacy settings naming the interface(s) to mock
+	Name string `yaml:"name"`
+
+	Packages map[string]struct {
+		Config     mockeryOptions              `yaml:"config"`
+		Interfaces map[string]mockeryInterface `yaml:"interfaces"`
+	} `yaml:"packages"`
+}
+
+// mockeryInterface is the configuration of an interface in a package.
+type mockeryInterface struct {
+	Config  mockeryOptions   `yaml:"config"`
+	Configs []mockeryOptions `yaml:"configs"`
+}
+
+// mockeryOptions are the mockery settings that can be set
+// at the top level, and for each package and interface.
+type mockeryOptions struct {
+	All       *bool  `yaml:"all"`
+	Recursive *bool  `yaml:"recursive"`
+	Dir       string `yaml:"dir"`
+	Filename  string `yaml:"filename"`
+	MockName  string `yaml:"mockname"`
+	InPackage *bool  `yaml:"inpackage"`
+}
+
+// mockeryInterfaceName matches the template action in a mockery mock
+// name that is replaced by the interface's name.
+var mockeryInterfaceName = regexp.MustCompile(`{{-?\s*\.InterfaceName\s*-?}}`)
+
+// merge returns the options, overridden by any set in other.
+func (o mockeryOptions) merge(other mockeryOptions) mockeryOptions {
+	if other.All != nil {
+		o.All = other.All
+	}
+	if other.Recursive != nil {
+		o.Recursive = other.Recursive
+	}
+	if other.Dir != "" {
+		o.Dir = other.Dir
+	}
+	if other.Filename != "" {
+		o.Filename = other.Filename
+	}
+	if other.MockName != "" {
+		o.MockName = other.MockName
+	}
+	if other.InPackage != nil {
+		o.InPackage = other.InPackage
+	}
+	return o
+}
+
+// migrateMockery translates the entries of a mockery configuration
+// file into mock directives for each of the configured packages.
+func migrateMockery(file string) ([]translation, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var cfg mockeryConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Packages == nil {
+		t := translation{pos: file, old: "(legacy configuration)"}
+		t.problem("only configurations with a packages section are supported")
+		return []translation{t}, nil
+	}
+
+	var translations []translation
+	for _, pkgPath := range sortedKeys(cfg.Packages) {
+		pkgCfg := cfg.Packages[pkgPath]
+		t := translation{pos: file, old: "packages: " + pkgPath}
+		translateMockery(&t, filepath.Dir(file), pkgPath, cfg.mockeryOptions.merge(pkgCfg.Config), pkgCfg.Interfaces)
+		if len(t.problems) > 0 {
+			t.new = nil
+		}
+		translations = append(translations, t)
+	}
+	return translations, nil
+}
+
+// translateMockery translates the configuration of a package, whose import
+// path is resolved relative to the module containing dir.
+func translateMockery(t *translation, dir, pkgPath string, opts mockeryOptions, ifaces map[string]mockeryInterface) {
+	t.note("mockery's testify-based mocks have no equivalent; " +
+		"the default mock style is generated instead, so update the tests that use them")
+	if opts.Recursive != nil && *opts.Recursive {
+		t.problem("recursive package configurations are not supported")
+		return
+	}
+	pkgDir, err := importDir(dir, pkgPath)
+	if err != nil {
+		t.problem("%s", err)
+		return
+	}
+	t.dir = pkgDir
+
+	// Each interface's options override the package's
+	ifaceOpts := map[string]mockeryOptions{}
+	if opts.All != nil && *opts.All {
+		names, err := declaredInterfaces(pkgDir)
+		if err != nil {
+			t.problem("error reading package: %s", err)
+			return
+		}
+		for _, name := range names {
+			ifaceOpts[name] = opts
+		}
+	}
+	for _, name := range sortedKeys(ifaces) {
+		ifaceOpts[name] = opts.merge(ifaces[name].Config)
+		if len(ifaces[name].Configs) > 0 {
+			t.problem("%s: multiple configs per interface are not supported", name)
+		}
+	}
+	if len(ifaceOpts) == 0 {
+		t.problem("no interfaces to mock")
+	}
+
+	var dirs []string
+	for _, name := range sortedKeys(ifaceOpts) {
+		o := ifaceOpts[name]
+		if o.Dir != "" && o.Dir != "{{.InterfaceDir}}" && filepath.Join(dir, o.Dir) != pkgDir && !slices.Contains(dirs, o.Dir) {
+			dirs = append(dirs, o.Dir)
+		}
+		args := []string{"mock"}
+		if o.MockName != "" {
+			mockName := mockeryInterfaceName.ReplaceAllString(o.MockName, name)
+			if strings.Contains(mockName, "{{") {
+				t.problem("%s: only mock names using {{.InterfaceName}} are supported", name)
+				continue
+			}
+			args = append(args, "-name", mockName)
+		}
+		out := o.Filename
+		if strings.Contains(out, "{{") {
+			out = snakeCase(name) + "_mock.go"
+			t.note("%s: filename templates are not supported; the mock will be written to %s instead of %s", name, out, o.Filename)
+		} else if out == "" {
+			out = snakeCase(name) + "_mock.go"
+		}
+		t.new = append(t.new, generateDirective(append(args, "-o", out, name)))
+	}
+	if len(t.problems) > 0 {
+		return
+	}
+	if len(dirs) > 0 {
+		t.note("the mocks will be generated into the package itself instead of %s", strings.Join(dirs, ", "))
+	} else if opts.InPackage == nil || !*opts.InPackage {
+		t.note("the mocks will be generated into the package itself instead of a separate mocks package")
+	}
+	t.note("the directives will be written to %s", filepath.Join(pkgDir, mockeryGenerateFile))
+}
+
+// declaredInterfaces returns the names of the interface types declared
+// at the top level of a Go source file, or of the non-test files in a
+// directory, other than type constraints (which can't be implemented).
+func declaredInterfaces(path string) ([]string, error) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if info.IsDir() {
+		pkgs, err := parser.ParseDir(fset, path, func(info os.FileInfo) bool {
+			return !strings.HasSuffix(info.Name(), "_test.go")
+		}, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				files = append(files, file)
+			}
+		}
+	} else {
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	ifaces := map[string]*ast.InterfaceType{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				if ifaceType, ok := spec.Type.(*ast.InterfaceType); ok {
+					ifaces[spec.Name.Name] = ifaceType
+				}
+			}
+		}
+	}
+	var names []string
+	for name, ifaceType := range ifaces {
+		if !isConstraint(ifaceType, ifaces, map[string]bool{name: true}) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// isConstraint reports whether the interface type is a type constraint:
+// whether it has union or tilde elements, or embeds comparable or another
+// of the interfaces that is a type constraint (other than those visited).
+func isConstraint(ifaceType *ast.InterfaceType, ifaces map[string]*ast.InterfaceType, visited map[string]bool) bool {
+	for _, field := range ifaceType.Methods.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		switch typ := field.Type.(type) {
+		case *ast.UnaryExpr, *ast.BinaryExpr:
+			return true
+		case *ast.Ident:
+			if typ.Name == "comparable" {
+				return true
+			}
+			if embedded, ok := ifaces[typ.Name]; ok && !visited[typ.Name] {
+				visited[typ.Name] = true
+				if isConstraint(embedded, ifaces, visited) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// importDir returns the directory of the package with the given import
+// path, which must be "." or a package in the module containing dir.
+func importDir(dir, importPath string) (string, error) {
+	if importPath == "." {
+		return dir, nil
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			modPath := modfile.ModulePath(data)
+			rel, ok := strings.CutPrefix(importPath, modPath)
+			if !ok || (rel != "" && rel[0] != '/') {
+				return "", fmt.Errorf("package %s is not in module %s", importPath, modPath)
+			}
+			return filepath.Join(dir, relPath(dir, filepath.Join(root, filepath.FromSlash(rel)))), nil
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", fmt.Errorf("no go.mod file found for package %s", importPath)
+		}
+		root = parent
+	}
+}
+
+// packageName returns the name of the package in dir,
+// ignoring any external _test package.
+func packageName(dir string) (string, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	var name string
+	for pkgName := range pkgs {
+		if name == "" || strings.HasSuffix(name, "_test") {
+			name = pkgName
+		}
+	}
+	if name == "" {
+		return "", fmt.Errorf("no Go files in %s", dir)
+	}
+	return name, nil
+}
+
+// generateDirective returns a go:generate directive running the
+// command with the given arguments, quoting them where necessary.
+func generateDirective(args []string) string {
+	var words []string
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"") {
+			arg = strconv.Quote(arg)
+		}
+		words = append(words, arg)
+	}
+	return "//go:generate " + strings.Join(words, " ")
+}
+
+// relPath returns path relative to base if possible, or path itself.
+func relPath(base, path string) string {
+	absBase, err1 := filepath.Abs(base)
+	absPath, err2 := filepath.Abs(path)
+	if err1 != nil || err2 != nil {
+		return path
+	}
+	rel, err := filepath.Rel(absBase, absPath)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+// snakeCase converts a Go identifier (e.g. "HTTPClient") to
+// snake case (e.g. "http_client").
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
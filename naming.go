@@ -0,0 +1,270 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"text/template"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+// Default suffixes of the names of the fields holding each method's stub
+// and counting its calls.
+const (
+	defaultStubSuffix   = "Stub"
+	defaultCalledSuffix = "Called"
+)
+
+// naming is how the type (and the fields) of an implementation are named.
+// Its zero value names them the way the style does by default.
+type naming struct {
+	// Name of the type, if not the interface's name with a prefix
+	// and suffix (those of the style, unless either is set)
+	name   string
+	prefix string
+	suffix string
+
+	// Suffixes of the names of each method's fields
+	stubSuffix   string
+	calledSuffix string
+}
+
+// register defines the flags that set the naming.
+func (n *naming) register(flags *flag.FlagSet) {
+	flags.StringVar(&n.name, "name", "", "Name of the generated type (default the interface's name, with the style's prefix or suffix, e.g. XxxMock)")
+	flags.StringVar(&n.prefix, "prefix", "", "Prefix added to the interface's name to name the generated type, instead of the style's")
+	flags.StringVar(&n.suffix, "suffix", "", "Suffix added to the interface's name to name the generated type, instead of the style's")
+	flags.StringVar(&n.stubSuffix, "stubsuffix", defaultStubSuffix, "Suffix added to each method's name to name its stub field (mock, fake and record styles)")
+	flags.StringVar(&n.calledSuffix, "calledsuffix", defaultCalledSuffix, "Suffix added to each method's name to name its call count field (mock and record styles)")
+}
+
+// typeName returns the name of the type implementing the interface in the
+// given style. With the record style, this is the name of the mock that
+// recorded calls are replayed to.
+func (n naming) typeName(style style, ifaceName string) string {
+	switch {
+	case n.name != "":
+		return n.name
+	case n.prefix != "" || n.suffix != "":
+		return n.prefix + ifaceName + n.suffix
+	default:
+		return style.prefix + ifaceName + style.suffix
+	}
+}
+
+// recordName returns the name that the record style's recorder, replayer
+// and recorded call types are named after: the interface's name, or the
+// name of the mock that's replayed to, if set by the naming flags (so that
+// an interface can be recorded under several names in one package).
+func (n naming) recordName(ifaceName string) string {
+	if n.name != "" {
+		return n.name
+	}
+	return n.prefix + ifaceName + n.suffix
+}
+
+// stub returns the name of the field holding the method's stub.
+func (n naming) stub(method string) string {
+	if n.stubSuffix == "" {
+		return method + defaultStubSuffix
+	}
+	return method + n.stubSuffix
+}
+
+// called returns the name of the field counting the method's calls.
+func (n naming) called(method string) string {
+	if n.calledSuffix == "" {
+		return method + defaultCalledSuffix
+	}
+	return method + n.calledSuffix
+}
+
+// funcs returns the template functions that name the implementation
+// of the interface in the given style.
+func (n naming) funcs(style style, ifaceName string) template.FuncMap {
+	typeName := n.typeName(style, ifaceName)
+	recordName := n.recordName(ifaceName)
+	return template.FuncMap{
+		"typeName":   func() string { return typeName },
+		"recordName": func() string { return recordName },
+		"stub":       n.stub,
+		"called":     n.called,
+	}
+}
+
+// check returns an error if the names can't be used for the implementation
+// of the interface in the given style: if they aren't valid identifiers, if
+// the style doesn't have the fields whose suffix is set, or if the members
+// of the implementation would collide.
+func (n naming) check(style style, styleName string, i iface.Interface) error {
+	typeName := n.typeName(style, i.Name)
+	if !token.IsIdentifier(typeName) {
+		return fmt.Errorf("invalid type name: %q", typeName)
+	}
+	if typeName == i.Name {
+		return fmt.Errorf("the generated type can't have the interface's name, %s", i.Name)
+	}
+	for _, suffix := range []struct {
+		flag, value, defaultValue string
+		supported                 bool
+	}{
+		{"stubsuffix", n.stubSuffix, defaultStubSuffix, style.stubField},
+		{"calledsuffix", n.calledSuffix, defaultCalledSuffix, style.calledField},
+	} {
+		if suffix.value == "" || suffix.value == suffix.defaultValue {
+			continue
+		}
+		if !suffix.supported {
+			return fmt.Errorf("the %s style doesn't support -%s", styleName, suffix.flag)
+		}
+		if !token.IsIdentifier("X" + suffix.value) {
+			return fmt.Errorf("invalid -%s: %q", suffix.flag, suffix.value)
+		}
+	}
+	return n.checkMembers(styleName, typeName, i)
+}
+
+// members collects the names of the fields and methods of a generated
+// type, along with descriptions of them, stopping at the first collision.
+type members struct {
+	typeName string
+	names    map[string]string
+	err      error
+}
+
+// newMembers returns the members of the named type, starting with the
+// interface's methods, so that they're the ones reported in collisions.
+func newMembers(typeName string, i iface.Interface) *members {
+	ms := &members{typeName: typeName, names: map[string]string{}}
+	for _, m := range i.Methods {
+		ms.add("the "+m.Name+" method", m.Name)
+	}
+	return ms
+}
+
+func (ms *members) add(desc string, names ...string) {
+	for _, name := range names {
+		if ms.err != nil {
+			return
+		}
+		if other, ok := ms.names[name]; ok {
+			ms.err = fmt.Errorf("%s: %s and %s are both named %s", ms.typeName, other, desc, name)
+			return
+		}
+		ms.names[name] = desc
+	}
+}
+
+// checkMembers returns an error if any of the fields or methods of the
+// implementation of the interface in the given style would have the same
+// name (e.g. if the interface has a Get method and a GetCalls method, and
+// the call count suffix of a mock is Calls, or if the interface of a
+// decorator has a Next method).
+func (n naming) checkMembers(styleName, typeName string, i iface.Interface) error {
+	ms := newMembers(typeName, i)
+	switch styleName {
+	case "mock":
+		n.mockMembers(ms, i)
+	case "record":
+		// The replayer returns a mock, which is checked
+		// first, since it's generated by its own directive
+		n.mockMembers(ms, i)
+		if ms.err != nil {
+			return ms.err
+		}
+		recorder := newMembers(n.recordName(i.Name)+"Recorder", i)
+		recorder.add("a field or method of every recorder", "Next", "mu", "calls", "err", "RecordedCalls", "SaveGolden", "record")
+		if recorder.err != nil {
+			return recorder.err
+		}
+		replayer := newMembers(n.recordName(i.Name)+"Replayer", iface.Interface{})
+		replayer.add("a field or method of every replayer", "T", "mu", "calls", "used", "Mock", "replay", "decode", "decodeError", "fail")
+		return replayer.err
+	case "logging":
+		ms.add("a field of every logging decorator", "Next", "Logger", "Level")
+	case "metrics":
+		ms.add("a field of every metrics decorator", "Next", "Recorder")
+	case "tracing":
+		ms.add("a field of every tracing decorator", "Next", "Tracer")
+	case "fake":
+		// Interfaces that can't be faked are reported when generating
+		if f, err := newFake(i); err == nil {
+			n.fakeMembers(ms, f.(*fake))
+		}
+	case "gomock":
+		ms.add("a field or method of every gomock mock", "ctrl", "recorder", "EXPECT")
+		if ms.err != nil {
+			return ms.err
+		}
+		recorder := newMembers(typeName+"MockRecorder", i)
+		recorder.add("a field of every gomock recorder", "mock")
+		return recorder.err
+	case "moq":
+		for _, m := range i.Methods {
+			ms.add("a field generated for "+m.Name, m.Name+"Func", "lock"+m.Name)
+		}
+		ms.add("a field of every moq mock", "calls")
+		for _, m := range i.Methods {
+			ms.add("a helper generated for "+m.Name, m.Name+"Calls")
+		}
+	case "counterfeiter":
+		for _, m := range i.Methods {
+			field := counterfeiterField(i.Methods, m.Name)
+			ms.add("a field generated for "+m.Name, m.Name+"Stub", field+"Mutex", field+"ArgsForCall")
+			if len(m.Results) > 0 {
+				ms.add("a field generated for "+m.Name, field+"Returns", field+"ReturnsOnCall")
+			}
+		}
+		ms.add("a field or method of every counterfeiter fake", "invocations", "invocationsMutex", "Invocations", "recordInvocation")
+		for _, m := range i.Methods {
+			ms.add("a helper generated for "+m.Name, m.Name+"CallCount", m.Name+"Calls")
+			if len(m.Params) > 0 {
+				ms.add("a helper generated for "+m.Name, m.Name+"ArgsForCall")
+			}
+			if len(m.Results) > 0 {
+				ms.add("a helper generated for "+m.Name, m.Name+"Returns", m.Name+"ReturnsOnCall")
+			}
+		}
+	}
+	return ms.err
+}
+
+// mockMembers adds the fields and methods of the mock of the interface.
+func (n naming) mockMembers(ms *members, i iface.Interface) {
+	for _, m := range i.Methods {
+		ms.add("the stub field of "+m.Name, n.stub(m.Name))
+		ms.add("the call count field of "+m.Name, n.called(m.Name))
+	}
+	ms.add("a field or method of every mock", "T", "mu", "StubUsage")
+	if len(i.Methods.ReturningError()) > 0 {
+		ms.add("a field or method of every mock", "FailAll")
+	}
+	for _, m := range i.Methods {
+		generated := []string{m.Name + "Calls", prefixed("On", m.Name), prefixed("Assert", m.Name) + "CalledWith",
+			"calls" + m.Name, "expectations" + m.Name, "expect" + m.Name, "record" + m.Name, "assertCalledWith" + m.Name}
+		if len(m.Results) > 0 {
+			generated = append(generated, m.Name+"ReturnsSequence")
+		}
+		if m.TakesContext() {
+			generated = append(generated, m.Name+"BlocksUntilCanceled", m.Name+"Delay")
+		}
+		if m.ReturnsError() {
+			generated = append(generated, prefixed("Fail", m.Name)+"With", prefixed("Fail", m.Name)+"OnCall")
+		}
+		ms.add("a helper generated for "+m.Name, generated...)
+	}
+}
+
+// fakeMembers adds the fields and methods of the fake.
+func (n naming) fakeMembers(ms *members, f *fake) {
+	for _, m := range f.FakeMethods {
+		if m.Kind == fakeOther {
+			ms.add("the stub field of "+m.Name, n.stub(m.Name))
+		}
+	}
+	if f.NeedsKeyFunc {
+		ms.add("a field of every fake", "Key")
+	}
+	ms.add("a field or method of every fake", "NotFound", "mu", "values", "keys", "notFound")
+}
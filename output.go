@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// writeIfChanged writes the data to the file, unless it already contains
+// exactly that data. It reports whether the file was written. The data is
+// written to a temporary file in the same directory, which is renamed into
+// place, so the file is never left partly written, even if writing fails or
+// another process writes it at the same time. An existing file's permissions
+// are kept (a new file gets the usual permissions, allowed by the umask), and
+// the file's directory is created if it doesn't exist.
+func writeIfChanged(file string, data []byte) (bool, error) {
+	var keepMode *fs.FileMode
+	existing, err := os.ReadFile(file)
+	switch {
+	case err == nil && bytes.Equal(existing, data):
+		return false, nil
+	case err == nil:
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+		mode := info.Mode().Perm()
+		keepMode = &mode
+	case !os.IsNotExist(err):
+		return false, err
+	}
+
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return false, err
+	}
+	tmp, err := createTemp(dir, "."+filepath.Base(file)+".")
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(tmp.Name()) // Fails harmlessly once renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	if keepMode != nil {
+		if err := os.Chmod(tmp.Name(), *keepMode); err != nil {
+			return false, err
+		}
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// createTemp creates a new file in the directory, whose name starts with the
+// prefix. Unlike os.CreateTemp, which only lets its owner read and write the
+// file, it creates the file with the permissions allowed by the umask, the
+// way os.Create would.
+func createTemp(dir, prefix string) (*os.File, error) {
+	for {
+		name := filepath.Join(dir, prefix+strconv.FormatUint(rand.Uint64(), 36))
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o666)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		return f, err
+	}
+}
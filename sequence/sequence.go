@@ -0,0 +1,54 @@
+// Package sequence defines the policies that determine what a generated
+// mock does once it has returned every value in a sequence of results
+// (see the XxxReturnsSequence methods of generated mocks).
+package sequence
+
+import "fmt"
+
+// Policy determines what happens once every value in a sequence
+// has been returned.
+type Policy int
+
+const (
+	// RepeatLast keeps returning the last value in the sequence.
+	RepeatLast Policy = iota
+
+	// Cycle starts again from the first value in the sequence.
+	Cycle
+
+	// Fail fails the test (through the mock's T) when the
+	// sequence is called again after its last value.
+	Fail
+)
+
+// Index returns the index of the value to return for the nth call (counting
+// from zero) to a sequence of the given length, or false if there is none.
+func (p Policy) Index(n, length int) (int, bool) {
+	if n < 0 || length < 1 {
+		return 0, false
+	}
+	if n < length {
+		return n, true
+	}
+	switch p {
+	case RepeatLast:
+		return length - 1, true
+	case Cycle:
+		return n % length, true
+	default:
+		return 0, false
+	}
+}
+
+func (p Policy) String() string {
+	switch p {
+	case RepeatLast:
+		return "RepeatLast"
+	case Cycle:
+		return "Cycle"
+	case Fail:
+		return "Fail"
+	default:
+		return fmt.Sprintf("Policy(%d)", int(p))
+	}
+}
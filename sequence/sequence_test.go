@@ -0,0 +1,56 @@
+package sequence
+
+import "testing"
+
+func TestPolicyIndex(t *testing.T) {
+	tests := []struct {
+		policy    Policy
+		n, length int
+		want      int
+		wantOK    bool
+	}{
+		// Within the sequence, every policy returns the nth value
+		{RepeatLast, 0, 3, 0, true},
+		{Cycle, 1, 3, 1, true},
+		{Fail, 2, 3, 2, true},
+
+		// After the sequence
+		{RepeatLast, 3, 3, 2, true},
+		{RepeatLast, 100, 3, 2, true},
+		{Cycle, 3, 3, 0, true},
+		{Cycle, 7, 3, 1, true},
+		{Cycle, 5, 1, 0, true},
+		{Fail, 3, 3, 0, false},
+		{Policy(99), 3, 3, 0, false},
+
+		// Out of bounds
+		{RepeatLast, -1, 3, 0, false},
+		{Cycle, -1, 3, 0, false},
+		{RepeatLast, 0, 0, 0, false},
+		{Cycle, 2, 0, 0, false},
+		{Fail, 0, -1, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.policy.Index(tt.n, tt.length)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s.Index(%d, %d) = %d, %t, want %d, %t", tt.policy, tt.n, tt.length, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestPolicyString(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		want   string
+	}{
+		{RepeatLast, "RepeatLast"},
+		{Cycle, "Cycle"},
+		{Fail, "Fail"},
+		{Policy(-1), "Policy(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.String(); got != tt.want {
+			t.Errorf("Policy(%d).String() = %q, want %q", int(tt.policy), got, tt.want)
+		}
+	}
+}
@@ -1,48 +1,657 @@
 package main
 
-var tmpl = `package {{ .Package }}
 import (
-	"sync/atomic"
+	"fmt"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nathanjcochran/mock/iface"
+)
+
+var funcs = template.FuncMap{
+	"comment":            comment,
+	"last":               last,
+	"trimParens":         trimParens,
+	"freeName":           freeName,
+	"freeNameIn":         freeNameIn,
+	"add":                func(a, b int) int { return a + b },
+	"moqNames":           moqNames,
+	"unshadowedNames":    unshadowedNames,
+	"counterfeiterField": counterfeiterField,
+	"exported":           exported,
+	"unexported":         unexported,
+	"prefixed":           prefixed,
+}
+
+// comment formats text (e.g. a doc comment extracted from the
+// original source) as a series of line comments.
+func comment(text string) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		lines = append(lines, strings.TrimSpace("// "+line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// last returns the last of a list of names, or "" if it's empty.
+func last(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return names[len(names)-1]
+}
+
+// trimParens removes the parentheses surrounding a result
+// list, so that it can be used as a parameter list.
+func trimParens(results string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(results, "("), ")")
+}
+
+// freeName returns a variable name based on the given name that won't
+// collide with (or be shadowed by) any of the method's params or results,
+// and won't shadow any of the packages the method's types refer to.
+func freeName(method iface.Method, name string) string {
+	return freeNameIn(iface.Methods{method}, name)
+}
+
+// freeNameIn returns a variable name based on the given name that won't
+// collide with (or be shadowed by) any of the methods' params or results,
+// and won't shadow any of the packages the methods' types refer to.
+func freeNameIn(methods iface.Methods, name string) string {
+	taken := map[string]bool{}
+	for _, method := range methods {
+		for _, n := range method.Params.Names() {
+			taken[n] = true
+		}
+		for _, n := range method.Results.Names() {
+			taken[n] = true
+		}
+	}
+	for taken[name] || slices.ContainsFunc(methods, func(m iface.Method) bool { return qualifies(m, name) }) {
+		name += "_"
+	}
+	return name
+}
+
+// packagePaths maps the names of the packages that templates refer to in
+// the bodies of generated methods, where the params and results of the
+// interface's methods could shadow them, to their import paths.
+var packagePaths = map[string]string{
+	"atomic":  "sync/atomic",
+	"context": "context",
+	"rand":    "math/rand/v2",
+	"reflect": "reflect",
+	"slog":    "log/slog",
+	"time":    "time",
+}
+
+// packageFuncs returns the template functions that refer to the packages in
+// packagePaths from the implementation of the interface: pkg returns the
+// name to refer to a package by, and importSpec returns its import spec. A
+// package is imported with an alias (e.g. time_) if any of the interface's
+// methods has a param or result with the package's name.
+func packageFuncs(i iface.Interface) template.FuncMap {
+	taken := map[string]bool{}
+	for _, m := range i.Methods {
+		for _, name := range m.Params.Names() {
+			taken[name] = true
+		}
+		for _, name := range m.Results.Names() {
+			taken[name] = true
+		}
+	}
+	pkg := func(name string) (string, error) {
+		if _, ok := packagePaths[name]; !ok {
+			return "", fmt.Errorf("unknown package: %s", name)
+		}
+		for taken[name] {
+			name += "_"
+		}
+		return name, nil
+	}
+	return template.FuncMap{
+		"pkg": pkg,
+		"importSpec": func(name string) (string, error) {
+			alias, err := pkg(name)
+			if err != nil {
+				return "", err
+			}
+			if alias != name {
+				return alias + " " + strconv.Quote(packagePaths[name]), nil
+			}
+			return strconv.Quote(packagePaths[name]), nil
+		},
+	}
+}
+
+// moqNames returns the name of each of the method's parameters, substituting
+// the names moq would generate for unnamed and blank parameters (i.e. "in1"),
+// and for parameters that would shadow a package the method's types refer to.
+func moqNames(method iface.Method) []string {
+	var names []string
+	for i, p := range method.Params {
+		name := p.Name
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("in%d", i+1)
+		}
+		if qualifies(method, name) {
+			name += "MoqParam"
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// unshadowedNames returns the names of the method's parameters (see
+// iface.Params.Names), with "Param" appended to those that would shadow a
+// package the method's types refer to, so that an implementation of the
+// method can refer to its types. (Underscores are appended to the names of
+// packages that would be shadowed by the parameters' original names.)
+func unshadowedNames(method iface.Method) []string {
+	names := method.Params.Names()
+	taken := map[string]bool{}
+	for _, name := range names {
+		taken[name] = true
+	}
+	for _, name := range method.Results.Names() {
+		taken[name] = true
+	}
+	for i, name := range names {
+		if !qualifies(method, name) {
+			continue
+		}
+		name += "Param"
+		for taken[name] || qualifies(method, name) {
+			name += "_"
+		}
+		taken[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// qualifies reports whether the name qualifies (i.e. is the name of the
+// package of) any of the types of the method's params or results.
+func qualifies(method iface.Method, name string) bool {
+	qualifier := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\.`)
+	for _, p := range method.Params {
+		if qualifier.MatchString(p.Type) {
+			return true
+		}
+	}
+	for _, r := range method.Results {
+		if qualifier.MatchString(r.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// counterfeiterField returns the prefix of the names of the private fields
+// that counterfeiter would generate for the named method (e.g. getMutex, for
+// Get). An unexported method's own helper methods have the same names (e.g.
+// getReturns, for get), so its prefix gets a suffix, as does the prefix of an
+// exported method whose name only differs from an unexported one's in case.
+func counterfeiterField(methods iface.Methods, name string) string {
+	if !token.IsExported(name) {
+		return name + "Unexported"
+	}
+	field := unexported(name)
+	for _, m := range methods {
+		if m.Name == field {
+			return field + "Exported"
+		}
+	}
+	return field
+}
+
+// exported capitalizes the first letter of a name.
+func exported(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// unexported lower-cases the first letter of a name.
+func unexported(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// prefixed returns the name of a method generated for the named method, made
+// up of the prefix followed by the method's name (e.g. OnGet). The prefix is
+// lower-cased for an unexported method (e.g. onGet), so that the generated
+// method is unexported too, and doesn't collide with that of an exported
+// method of the same name (i.e. Get).
+func prefixed(prefix, name string) string {
+	if token.IsExported(name) {
+		return prefix + name
+	}
+	return unexported(prefix) + exported(name)
+}
+
+// style is a style of implementation that can be generated.
+type style struct {
+	tmpl string
+
+	// Prefix and suffix added to the interface's name
+	// to name the implementation, by default
+	prefix string
+	suffix string
+
+	// Whether the implementation has stub fields and
+	// call count fields named with a suffix (see naming)
+	stubField   bool
+	calledField bool
+
+	// Returns the data to execute the template with,
+	// if it isn't just the interface itself
+	data func(iface.Interface) (any, error)
+}
+
+// styles maps the name of each style of
+// implementation that can be generated to it.
+var styles = map[string]style{
+	"mock":          {tmpl: mockTmpl, suffix: "Mock", stubField: true, calledField: true},
+	"logging":       {tmpl: loggingTmpl, suffix: "Logging"},
+	"metrics":       {tmpl: metricsTmpl, suffix: "Metrics"},
+	"tracing":       {tmpl: tracingTmpl, suffix: "Tracing"},
+	"record":        {tmpl: recordTmpl, suffix: "Mock", stubField: true, calledField: true},
+	"fake":          {tmpl: fakeTmpl, suffix: "Fake", data: newFake, stubField: true},
+	"gomock":        {tmpl: gomockTmpl, prefix: "Mock"},
+	"moq":           {tmpl: moqTmpl, suffix: "Mock"},
+	"counterfeiter": {tmpl: counterfeiterTmpl, prefix: "Fake"},
+}
+
+var mockTmpl = `package {{ .Package }}
+import (
+	"sync"
+	{{ importSpec "atomic" }}
+	{{ importSpec "rand" }}
+	{{ importSpec "time" }}
+	"github.com/nathanjcochran/mock/match"
+	"github.com/nathanjcochran/mock/sequence"
+	"github.com/nathanjcochran/mock/usage"
 	{{- range .Imports }}
 	{{ . }}
 	{{- end }}
 )
 
-// {{ .Name }}Mock is a mock implementation of the {{ .Name }}
+// {{ typeName }} is a mock implementation of the {{ .Name }}
 // interface.
-type {{ .Name }}Mock{{ .TypeParams }} struct {
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+type {{ typeName }}{{ .TypeParams }} struct {
 	T *testing.T
 	{{- range .Methods }}
-	{{ .Name }}Stub func({{ .Params }}) {{ .Results }}
-	{{ .Name }}Called int32
+	{{- with .Doc }}
+	{{ comment . }}
+	{{- end }}
+	{{ stub .Name }} func({{ .Params }}) {{ .Results }}
+	{{ called .Name }} int32
+	{{- end }}
+
+	mu sync.Mutex
+	{{- range .Methods }}
+	calls{{ .Name }} []{{ typeName }}{{ .Name }}Args{{ $.TypeParams.Names }}
+	expectations{{ .Name }} []*{{ typeName }}{{ .Name }}Expectation{{ $.TypeParams.Names }}
 	{{- end }}
 }
 
-// Verify that *{{ .Name }}Mock implements {{ .Name }}.
+// Verify that *{{ typeName }} implements {{ .Name }}.
 {{- if .TypeParams }}
 func _{{ .TypeParams }}() {
-    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ .Name }}Mock{{ .TypeParams.Names }}{}
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
 }
 {{ else }}
-var _ {{ .Name }} = &{{ .Name }}Mock{}
+var _ {{ .Name }} = &{{ typeName }}{}
 {{ end }}
 
 {{- range .Methods }}
+{{- $method := . }}
+{{- $mock := printf "%s%s" typeName $.TypeParams.Names }}
+{{- $args := printf "%s%sArgs%s" typeName .Name $.TypeParams.Names }}
+{{- $results := printf "%s%sResults%s" typeName .Name $.TypeParams.Names }}
+{{- $expectation := printf "%s%sExpectation%s" typeName .Name $.TypeParams.Names }}
+{{- $paramNames := printf "%#v" .Params.Names }}
+{{- $m := freeName . "m" }}
+{{- $exp := freeName . "exp" }}
 
 // {{ .Name}} is a stub for the {{ $.Name }}.{{ .Name }}
 // method that records the number of times it has been called.
-func (m *{{ $.Name }}Mock{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results }}{
-	atomic.AddInt32(&m.{{ .Name }}Called, 1) 
-	if m.{{ .Name }}Stub == nil {
-		if m.T != nil {
-			m.T.Error("{{ .Name }}Stub is nil")
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $m }} *{{ $mock }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results }}{
+	{{ pkg "atomic" }}.AddInt32(&{{ $m }}.{{ called .Name }}, 1) 
+	if {{ $exp }} := {{ $m }}.record{{ .Name }}({{ $args }}{
+		{{- range $i, $field := .Params.FieldNames }}{{ $field }}: {{ index $method.Params.Names $i }}, {{ end }}}); {{ $exp }} != nil {
+		{{- if gt (len .Results) 0 }}
+		return {{ range $i, $field := .Results.FieldNames }}{{ if $i }}, {{ end }}{{ $exp }}.results.{{ $field }}{{ end }}
+		{{- else }}
+		return
+		{{- end }}
+	}
+	if {{ $m }}.{{ stub .Name }} == nil {
+		if {{ $m }}.T != nil {
+			{{ $m }}.T.Error("{{ stub .Name }} is nil")
 		}
 		panic("{{ .Name }} unimplemented")
 	}
 	{{- if gt (len .Results) 0 }}
-	return m.{{ .Name }}Stub({{ .Params.ArgsString }})
+	return {{ $m }}.{{ stub .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $m }}.{{ stub .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+}
+
+// {{ typeName }}{{ .Name }}Args holds the arguments
+// of a call to {{ typeName }}.{{ .Name }}.
+type {{ typeName }}{{ .Name }}Args{{ $.TypeParams }} struct {
+	{{- range $i, $field := .Params.FieldNames }}
+	{{ $field }} {{ (index $method.Params $i).Type }}
+	{{- end }}
+}
+
+func (args {{ $args }}) call() match.Call {
+	return match.Call{ {{- range .Params.FieldNames }}args.{{ . }}, {{ end }}}
+}
+
+// matchers returns a matcher for each of the given arguments, converting
+// plain values to the params' types (see match.OfType). It's a method of
+// {{ $args }}, rather than of the mock, so that the params of {{ .Name }}
+// can't shadow the match package or the params' types.
+func ({{ $args }}) matchers(vs ...any) []match.Matcher {
+	return []match.Matcher{ {{- range $i, $param := .Params }}match.OfType[{{ .Type }}](vs[{{ $i }}]), {{ end }}}
+}
+
+// {{ .Name }}Calls returns the arguments of each call
+// made to {{ .Name }} so far.
+func (m *{{ $mock }}) {{ .Name }}Calls() []{{ $args }} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]{{ $args }}(nil), m.calls{{ .Name }}...)
+}
+
+// {{ typeName }}{{ .Name }}Expectation is an expected call
+// to {{ typeName }}.{{ .Name }}, registered with {{ prefixed "On" .Name }}.
+type {{ typeName }}{{ .Name }}Expectation{{ $.TypeParams }} struct {
+	matchers []match.Matcher
+	{{- if gt (len .Results) 0 }}
+	results  {{ $results }}
+	{{- end }}
+	calls    int
+}
+
+{{- if gt (len .Results) 0 }}
+
+// Return sets the results of calls matching the expectation,
+// which otherwise return zero values.
+func ({{ $exp }} *{{ $expectation }}) Return({{ .Results.NamedString | trimParens }}) {
+	{{ $exp }}.results = {{ $results }}{
+		{{- range $i, $field := .Results.FieldNames }}{{ $field }}: {{ index $method.Results.Names $i }}, {{ end }}}
+}
+{{- end }}
+
+func (exp *{{ $expectation }}) matches(args {{ $args }}) bool {
+	{{- if .Params }}
+	return {{ range $i, $field := .Params.FieldNames }}{{ if $i }} &&
+		{{ end }}exp.matchers[{{ $i }}].Matches(args.{{ $field }})
+	{{- end }}
 	{{- else }}
-	m.{{ .Name }}Stub({{ .Params.ArgsString }})
+	return true
+	{{- end }}
+}
+
+// {{ prefixed "On" .Name }} registers an expected call to {{ .Name }}, with arguments
+// matching the given matchers. Plain values (i.e. non-matchers) match
+// arguments that are deeply equal to them, once converted to the param's
+// type (e.g. 1 to an int64). Calls matching an expectation
+// return its results, rather than calling {{ stub .Name }}. Once any
+// expectations are registered, calls that don't match one of them
+// fail the test, unless {{ stub .Name }} is set.
+func ({{ $m }} *{{ $mock }}) {{ prefixed "On" .Name }}({{ range $i, $name := .Params.Names }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}{{ if .Params }} any{{ end }}) *{{ $expectation }} {
+	return {{ $m }}.expect{{ .Name }}(&{{ $expectation }}{
+		matchers: {{ $args }}{}.matchers({{ range $i, $name := .Params.Names }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}),
+	})
+}
+
+func (m *{{ $mock }}) expect{{ .Name }}(exp *{{ $expectation }}) *{{ $expectation }} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectations{{ .Name }} = append(m.expectations{{ .Name }}, exp)
+	return exp
+}
+
+func (m *{{ $mock }}) record{{ .Name }}(args {{ $args }}) *{{ $expectation }} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls{{ .Name }} = append(m.calls{{ .Name }}, args)
+	for _, exp := range m.expectations{{ .Name }} {
+		if exp.matches(args) {
+			exp.calls++
+			return exp
+		}
+	}
+	if len(m.expectations{{ .Name }}) > 0 && m.{{ stub .Name }} == nil {
+		var expectations [][]match.Matcher
+		for _, exp := range m.expectations{{ .Name }} {
+			expectations = append(expectations, exp.matchers)
+		}
+		msg := match.UnexpectedCallReport("{{ .Name }}", {{ $paramNames }}, args.call(), expectations)
+		if m.T != nil {
+			m.T.Error(msg)
+		}
+		panic(msg)
+	}
+	return nil
+}
+
+// {{ prefixed "Assert" .Name }}CalledWith fails the test unless {{ .Name }} has been
+// called with arguments matching the given matchers (or deeply equal to
+// the given plain values). It returns whether it has been.
+func ({{ $m }} *{{ $mock }}) {{ prefixed "Assert" .Name }}CalledWith({{ range $i, $name := .Params.Names }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}{{ if .Params }} any{{ end }}) bool {
+	if {{ $m }}.T != nil {
+		{{ $m }}.T.Helper()
+	}
+	return {{ $m }}.assertCalledWith{{ .Name }}(&{{ $expectation }}{
+		matchers: {{ $args }}{}.matchers({{ range $i, $name := .Params.Names }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}),
+	})
+}
+
+func (m *{{ $mock }}) assertCalledWith{{ .Name }}(exp *{{ $expectation }}) bool {
+	var calls []match.Call
+	for _, args := range m.{{ .Name }}Calls() {
+		if exp.matches(args) {
+			return true
+		}
+		calls = append(calls, args.call())
+	}
+	msg := match.NotCalledReport("{{ .Name }}", {{ $paramNames }}, exp.matchers, calls)
+	if m.T == nil {
+		panic(msg)
+	}
+	m.T.Helper()
+	m.T.Error(msg)
+	return false
+}
+{{- if gt (len .Results) 0 }}
+
+// {{ typeName }}{{ .Name }}Results holds the results
+// of a call to {{ typeName }}.{{ .Name }}.
+type {{ typeName }}{{ .Name }}Results{{ $.TypeParams }} struct {
+	{{- range $i, $field := .Results.FieldNames }}
+	{{ $field }} {{ (index $method.Results $i).Type }}
+	{{- end }}
+}
+
+// {{ .Name }}ReturnsSequence sets {{ stub .Name }} to return each of the
+// given results in turn. The policy determines what happens once
+// they've all been returned.
+func (m *{{ $mock }}) {{ .Name }}ReturnsSequence(policy sequence.Policy, results ...{{ $results }}) {
+	var calls int32
+	m.{{ stub .Name }} = func({{ .Params.TypesString }}) {{ .Results.TypesString }} {
+		n := {{ pkg "atomic" }}.AddInt32(&calls, 1)
+		i, ok := policy.Index(int(n-1), len(results))
+		if !ok {
+			msg := fmt.Sprintf("{{ .Name }} called %d times, but only %d results were sequenced", n, len(results))
+			if m.T != nil {
+				m.T.Error(msg)
+			}
+			panic(msg)
+		}
+		return {{ range $i, $field := .Results.FieldNames }}{{ if $i }}, {{ end }}results[i].{{ $field }}{{ end }}
+	}
+}
+{{- end }}
+{{- if .TakesContext }}
+{{- $ctx := index .Params.Names 0 }}
+{{- $stub := freeName . "stub" }}
+{{- $timer := freeName . "timer" }}
+{{- $delay := freeName . "delay" }}
+
+// {{ .Name }}BlocksUntilCanceled sets {{ stub .Name }} to block until the
+// call's context is done, and then return
+{{- if .ReturnsError }} the context's error (along with
+// zero values for any other results).
+{{- else }} zero values.
+{{- end }}
+func (m *{{ $mock }}) {{ .Name }}BlocksUntilCanceled() {
+	m.{{ stub .Name }} = func({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+		<-{{ $ctx }}.Done()
+		{{- if .ReturnsError }}
+		{{ last .Results.Names }} = {{ $ctx }}.Err()
+		{{- end }}
+		{{- if .Results }}
+		return {{ .Results.VarsString }}
+		{{- end }}
+	}
+}
+
+// {{ .Name }}Delay wraps {{ stub .Name }}, so that calls wait for the given
+// duration before calling it (or returning zero values, if it is nil).
+// If the call's context is done first, the call returns
+{{- if .ReturnsError }} the context's
+// error (along with zero values for any other results)
+{{- else }} zero values
+{{- end }} without
+// calling the stub.
+func (m *{{ $mock }}) {{ .Name }}Delay({{ $delay }} {{ pkg "time" }}.Duration) {
+	{{ $stub }} := m.{{ stub .Name }}
+	m.{{ stub .Name }} = func({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+		{{ $timer }} := {{ pkg "time" }}.NewTimer({{ $delay }})
+		defer {{ $timer }}.Stop()
+		select {
+		case <-{{ $ctx }}.Done():
+			{{- if .ReturnsError }}
+			{{ last .Results.Names }} = {{ $ctx }}.Err()
+			{{- end }}
+			return {{ .Results.VarsString }}
+		case <-{{ $timer }}.C:
+		}
+		if {{ $stub }} == nil {
+			return {{ .Results.VarsString }}
+		}
+		{{- if .Results }}
+		return {{ $stub }}({{ .Params.ArgsString }})
+		{{- else }}
+		{{ $stub }}({{ .Params.ArgsString }})
+		{{- end }}
+	}
+}
+{{- end }}
+{{- if .ReturnsError }}
+{{- $stub := freeName . "stub" }}
+{{- $err := freeName . "err" }}
+{{- $rate := freeName . "rate" }}
+{{- $n := freeName . "n" }}
+{{- $calls := freeName . "calls" }}
+
+// {{ prefixed "Fail" .Name }}With wraps {{ stub .Name }}, so that calls fail with the
+// given error (and zero values for any other results) at the given rate,
+// between 0 and 1. Other calls call the stub (or return zero values,
+// if it is nil).
+func (m *{{ $mock }}) {{ prefixed "Fail" .Name }}With({{ $err }} error, {{ $rate }} float64) {
+	{{ $stub }} := m.{{ stub .Name }}
+	m.{{ stub .Name }} = func({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+		if {{ pkg "rand" }}.Float64() < {{ $rate }} {
+			{{ last .Results.Names }} = {{ $err }}
+			return {{ .Results.VarsString }}
+		}
+		if {{ $stub }} == nil {
+			return {{ .Results.VarsString }}
+		}
+		return {{ $stub }}({{ .Params.ArgsString }})
+	}
+}
+
+// {{ prefixed "Fail" .Name }}OnCall wraps {{ stub .Name }}, so that the nth call made
+// after it is wrapped (counting from 1) fails with the given error (and
+// zero values for any other results). Other calls call the stub (or
+// return zero values, if it is nil).
+func (m *{{ $mock }}) {{ prefixed "Fail" .Name }}OnCall({{ $n }} int, {{ $err }} error) {
+	{{ $stub }} := m.{{ stub .Name }}
+	var {{ $calls }} int32
+	m.{{ stub .Name }} = func({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+		if int({{ pkg "atomic" }}.AddInt32(&{{ $calls }}, 1)) == {{ $n }} {
+			{{ last .Results.Names }} = {{ $err }}
+			return {{ .Results.VarsString }}
+		}
+		if {{ $stub }} == nil {
+			return {{ .Results.VarsString }}
+		}
+		return {{ $stub }}({{ .Params.ArgsString }})
+	}
+}
+{{- end }}
+{{- end }}
+
+
+// StubUsage returns the usage of each of the stubs that are set, and each
+// of the registered expectations, so that the ones that are never called
+// can be reported (see usage.Check).
+func (m *{{ typeName }}{{ .TypeParams.Names }}) StubUsage() []usage.Stub {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var stubs []usage.Stub
+
+	// Calls that don't match an expectation are made to the stub
+	{{- range .Methods }}
+	{
+		calls := int({{ pkg "atomic" }}.LoadInt32(&m.{{ called .Name }}))
+		for _, exp := range m.expectations{{ .Name }} {
+			stubs = append(stubs, usage.Stub{
+				Mock:        "{{ typeName }}",
+				Method:      "{{ .Name }}",
+				Expectation: match.Describe("{{ .Name }}", {{ printf "%#v" .Params.Names }}, exp.matchers),
+				OnMethod:    "{{ prefixed "On" .Name }}",
+				Calls:       exp.calls,
+			})
+			calls -= exp.calls
+		}
+		if m.{{ stub .Name }} != nil {
+			stubs = append(stubs, usage.Stub{Mock: "{{ typeName }}", Method: "{{ .Name }}", Field: "{{ stub .Name }}", Calls: calls})
+		}
+	}
+	{{- end }}
+	return stubs
+}
+{{- if .Methods.ReturningError }}
+
+// FailAll wraps the stubs of all of the methods whose last result is an
+// error, so that every call to them fails with the given error (and
+// zero values for any other results).
+func (m *{{ typeName }}{{ .TypeParams.Names }}) FailAll(err error) {
+	{{- range .Methods.ReturningError }}
+	m.{{ prefixed "Fail" .Name }}With(err, 1)
 	{{- end }}
 }
 {{- end -}}
@@ -0,0 +1,367 @@
+package main
+
+var gomockTmpl = `package {{ .Package }}
+import (
+	{{ importSpec "reflect" }}
+	"go.uber.org/mock/gomock"
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// {{ typeName }} is a mock of {{ .Name }} interface, compatible with the
+// mocks generated by mockgen (go.uber.org/mock).
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+type {{ typeName }}{{ .TypeParams }} struct {
+	ctrl     *gomock.Controller
+	recorder *{{ typeName }}MockRecorder{{ .TypeParams.Names }}
+}
+
+// {{ typeName }}MockRecorder is the mock recorder for {{ typeName }}.
+type {{ typeName }}MockRecorder{{ .TypeParams }} struct {
+	mock *{{ typeName }}{{ .TypeParams.Names }}
+}
+
+// New{{ typeName }} creates a new mock instance.
+func New{{ typeName }}{{ .TypeParams }}(ctrl *gomock.Controller) *{{ typeName }}{{ .TypeParams.Names }} {
+	mock := &{{ typeName }}{{ .TypeParams.Names }}{ctrl: ctrl}
+	mock.recorder = &{{ typeName }}MockRecorder{{ .TypeParams.Names }}{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *{{ typeName }}{{ .TypeParams.Names }}) EXPECT() *{{ typeName }}MockRecorder{{ .TypeParams.Names }} {
+	return m.recorder
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+{{- range .Methods }}
+{{- $method := . }}
+{{- $ret := freeName . "ret" }}
+{{- $varargs := freeName . "varargs" }}
+{{- $m := freeName . "m" }}
+{{- $mr := freeName . "mr" }}
+{{- $a := freeName . "a" }}
+{{- $names := unshadowedNames . }}
+{{- $last := len .Params | add -1 }}
+{{- $variadic := and .Params (index .Params $last).Variadic }}
+
+// {{ .Name }} mocks base method.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $m }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ index $names $i }} {{ .TypeString }}{{ end }}) {{ .Results.TypesString }}{
+	{{ $m }}.ctrl.T.Helper()
+	{{- if $variadic }}
+	{{ $varargs }} := []any{ {{- range $i, $name := $names }}{{ if lt $i $last }}{{ $name }}, {{ end }}{{ end }}}
+	for _, {{ $a }} := range {{ index $names $last }} {
+		{{ $varargs }} = append({{ $varargs }}, {{ $a }})
+	}
+	{{ if .Results }}{{ $ret }} := {{ end }}{{ $m }}.ctrl.Call({{ $m }}, "{{ .Name }}", {{ $varargs }}...)
+	{{- else }}
+	{{ if .Results }}{{ $ret }} := {{ end }}{{ $m }}.ctrl.Call({{ $m }}, "{{ .Name }}"{{ range $names }}, {{ . }}{{ end }})
+	{{- end }}
+	{{- range $i, $result := .Results }}
+	{{ $ret }}{{ $i }}, _ := {{ $ret }}[{{ $i }}].({{ $result.Type }})
+	{{- end }}
+	{{- if .Results }}
+	return {{ range $i, $result := .Results }}{{ if $i }}, {{ end }}{{ $ret }}{{ $i }}{{ end }}
+	{{- end }}
+}
+
+// {{ .Name }} indicates an expected call of {{ .Name }}.
+func ({{ $mr }} *{{ typeName }}MockRecorder{{ $.TypeParams.Names }}) {{ .Name }}(
+	{{- range $i, $name := $names }}{{ if $i }}, {{ end }}{{ $name }}{{ if and $variadic (eq $i $last) }} ...any{{ else if or (eq $i $last) (and $variadic (eq (add $i 1) $last)) }} any{{ end }}{{ end }}) *gomock.Call {
+	{{ $mr }}.mock.ctrl.T.Helper()
+	{{- if $variadic }}
+	{{ $varargs }} := append([]any{ {{- range $i, $name := $names }}{{ if lt $i $last }}{{ $name }}, {{ end }}{{ end }}}, {{ index $names $last }}...)
+	return {{ $mr }}.mock.ctrl.RecordCallWithMethodType({{ $mr }}.mock, "{{ .Name }}", {{ pkg "reflect" }}.TypeOf((*{{ typeName }}{{ $.TypeParams.Names }})(nil).{{ .Name }}), {{ $varargs }}...)
+	{{- else }}
+	return {{ $mr }}.mock.ctrl.RecordCallWithMethodType({{ $mr }}.mock, "{{ .Name }}", {{ pkg "reflect" }}.TypeOf((*{{ typeName }}{{ $.TypeParams.Names }})(nil).{{ .Name }}){{ range $names }}, {{ . }}{{ end }})
+	{{- end }}
+}
+{{- end -}}
+`
+
+var moqTmpl = `package {{ .Package }}
+import (
+	"sync"
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+{{- define "moqCall" }}struct {
+	{{- $names := moqNames . }}
+	{{- range $i, $param := .Params }}
+	// {{ exported (index $names $i) }} is the {{ index $names $i }} argument value.
+	{{ exported (index $names $i) }} {{ .Type }}
+	{{- end }}
+}{{ end }}
+
+// Ensure, that {{ typeName }} does implement {{ .Name }}.
+// If this is not the case, regenerate this file with mock.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+// {{ typeName }} is a mock implementation of {{ .Name }}, compatible
+// with the mocks generated by moq (github.com/matryer/moq).
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+type {{ typeName }}{{ .TypeParams }} struct {
+	{{- range .Methods }}
+	{{- $names := moqNames . }}
+	// {{ .Name }}Func mocks the {{ .Name }} method.
+	{{ .Name }}Func func({{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ index $names $i }} {{ .TypeString }}{{ end }}) {{ .Results }}
+	{{ end }}
+	// calls tracks calls to the methods.
+	calls struct {
+		{{- range .Methods }}
+		// {{ .Name }} holds details about calls to the {{ .Name }} method.
+		{{ .Name }} []{{ template "moqCall" . }}
+		{{- end }}
+	}
+	{{- range .Methods }}
+	lock{{ .Name }} sync.RWMutex
+	{{- end }}
+}
+
+{{- range .Methods }}
+{{- $mock := freeName . "mock" }}
+{{- $callInfo := freeName . "callInfo" }}
+{{- $calls := freeName . "calls" }}
+{{- $names := moqNames . }}
+
+// {{ .Name }} calls {{ .Name }}Func.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $mock }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ index $names $i }} {{ .TypeString }}{{ end }}) {{ .Results.TypesString }}{
+	if {{ $mock }}.{{ .Name }}Func == nil {
+		panic("{{ typeName }}.{{ .Name }}Func: method is nil but {{ $.Name }}.{{ .Name }} was just called")
+	}
+	{{ $callInfo }} := {{ template "moqCall" . }}{
+		{{- range $i, $name := $names }}
+		{{ exported $name }}: {{ $name }},
+		{{- end }}
+	}
+	{{ $mock }}.lock{{ .Name }}.Lock()
+	{{ $mock }}.calls.{{ .Name }} = append({{ $mock }}.calls.{{ .Name }}, {{ $callInfo }})
+	{{ $mock }}.lock{{ .Name }}.Unlock()
+	{{ if .Results }}return {{ end }}{{ $mock }}.{{ .Name }}Func({{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ index $names $i }}{{ if .Variadic }}...{{ end }}{{ end }})
+}
+
+// {{ .Name }}Calls gets all the calls that were made to {{ .Name }}.
+// Check the length with:
+//
+//	len(mocked{{ $.Name }}.{{ .Name }}Calls())
+func ({{ $mock }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}Calls() []{{ template "moqCall" . }} {
+	var {{ $calls }} []{{ template "moqCall" . }}
+	{{ $mock }}.lock{{ .Name }}.RLock()
+	{{ $calls }} = {{ $mock }}.calls.{{ .Name }}
+	{{ $mock }}.lock{{ .Name }}.RUnlock()
+	return {{ $calls }}
+}
+{{- end -}}
+`
+
+var counterfeiterTmpl = `package {{ .Package }}
+import (
+	"sync"
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+{{- define "counterfeiterArgs" }}struct {
+	{{- range $i, $param := .Params }}
+	arg{{ add $i 1 }} {{ .Type }}
+	{{- end }}
+}{{ end }}
+
+{{- define "counterfeiterResults" }}struct {
+	{{- range $i, $result := .Results }}
+	result{{ add $i 1 }} {{ .Type }}
+	{{- end }}
+}{{ end }}
+
+// {{ typeName }} is a fake implementation of {{ .Name }}, compatible with
+// the fakes generated by counterfeiter (github.com/maxbrunsfeld/counterfeiter).
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+type {{ typeName }}{{ .TypeParams }} struct {
+	{{- range .Methods }}
+	{{- $field := counterfeiterField $.Methods .Name }}
+	{{ .Name }}Stub func({{ .Params.TypesString }}) {{ .Results }}
+	{{ $field }}Mutex sync.RWMutex
+	{{ $field }}ArgsForCall []{{ template "counterfeiterArgs" . }}
+	{{- if .Results }}
+	{{ $field }}Returns {{ template "counterfeiterResults" . }}
+	{{ $field }}ReturnsOnCall map[int]{{ template "counterfeiterResults" . }}
+	{{- end }}
+	{{- end }}
+	invocations      map[string][][]any
+	invocationsMutex sync.RWMutex
+}
+
+{{- range .Methods }}
+{{- $field := counterfeiterField $.Methods .Name }}
+{{- $fake := freeName . "fake" }}
+{{- $stub := freeName . "stub" }}
+{{- $ret := freeName . "ret" }}
+{{- $specificReturn := freeName . "specificReturn" }}
+{{- $fakeReturns := freeName . "fakeReturns" }}
+{{- $argsForCall := freeName . "argsForCall" }}
+{{- $index := freeName . "i" }}
+
+{{- $args := "" }}
+{{- $params := "" }}
+{{- $argsList := "" }}
+{{- range $i, $param := .Params }}
+{{- if $i }}{{ $params = print $params ", " }}{{ $argsList = print $argsList ", " }}{{ $args = print $args ", " }}{{ end }}
+{{- $params = print $params "arg" (add $i 1) " " .TypeString }}
+{{- $argsList = print $argsList "arg" (add $i 1) }}
+{{- $args = print $args "arg" (add $i 1) }}
+{{- if .Variadic }}{{ $args = print $args "..." }}{{ end }}
+{{- end }}
+
+{{- $results := "" }}
+{{- $resultsList := "" }}
+{{- range $i, $result := .Results }}
+{{- if $i }}{{ $results = print $results ", " }}{{ $resultsList = print $resultsList ", " }}{{ end }}
+{{- $results = print $results "result" (add $i 1) " " .Type }}
+{{- $resultsList = print $resultsList "result" (add $i 1) }}
+{{- end }}
+
+// {{ .Name }} records the call, and returns the results of the stub set
+// with {{ .Name }}Calls or the results set with {{ .Name }}Returns.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ $params }}) {{ .Results.TypesString }} {
+	{{ $fake }}.{{ $field }}Mutex.Lock()
+	{{- if .Results }}
+	{{ $ret }}, {{ $specificReturn }} := {{ $fake }}.{{ $field }}ReturnsOnCall[len({{ $fake }}.{{ $field }}ArgsForCall)]
+	{{- end }}
+	{{ $fake }}.{{ $field }}ArgsForCall = append({{ $fake }}.{{ $field }}ArgsForCall, {{ template "counterfeiterArgs" . }}{ {{- $argsList -}} })
+	{{ $stub }} := {{ $fake }}.{{ .Name }}Stub
+	{{- if .Results }}
+	{{ $fakeReturns }} := {{ $fake }}.{{ $field }}Returns
+	{{- end }}
+	{{ $fake }}.recordInvocation("{{ .Name }}", []any{ {{- $argsList -}} })
+	{{ $fake }}.{{ $field }}Mutex.Unlock()
+	if {{ $stub }} != nil {
+		{{ if .Results }}return {{ end }}{{ $stub }}({{ $args }})
+		{{- if not .Results }}
+		return
+		{{- end }}
+	}
+	{{- if .Results }}
+	if {{ $specificReturn }} {
+		return {{ range $i, $result := .Results }}{{ if $i }}, {{ end }}{{ $ret }}.result{{ add $i 1 }}{{ end }}
+	}
+	return {{ range $i, $result := .Results }}{{ if $i }}, {{ end }}{{ $fakeReturns }}.result{{ add $i 1 }}{{ end }}
+	{{- end }}
+}
+
+// {{ .Name }}CallCount returns the number of calls made to {{ .Name }}.
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}CallCount() int {
+	{{ $fake }}.{{ $field }}Mutex.RLock()
+	defer {{ $fake }}.{{ $field }}Mutex.RUnlock()
+	return len({{ $fake }}.{{ $field }}ArgsForCall)
+}
+
+// {{ .Name }}Calls sets a function to handle calls to {{ .Name }}.
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}Calls({{ $stub }} func({{ .Params.TypesString }}) {{ .Results }}) {
+	{{ $fake }}.{{ $field }}Mutex.Lock()
+	defer {{ $fake }}.{{ $field }}Mutex.Unlock()
+	{{ $fake }}.{{ .Name }}Stub = {{ $stub }}
+}
+{{- if .Params }}
+
+// {{ .Name }}ArgsForCall returns the arguments of the i-th call to {{ .Name }}.
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}ArgsForCall({{ $index }} int) ({{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ .Type }}{{ end }}) {
+	{{ $fake }}.{{ $field }}Mutex.RLock()
+	defer {{ $fake }}.{{ $field }}Mutex.RUnlock()
+	{{ $argsForCall }} := {{ $fake }}.{{ $field }}ArgsForCall[{{ $index }}]
+	return {{ range $i, $param := .Params }}{{ if $i }}, {{ end }}{{ $argsForCall }}.arg{{ add $i 1 }}{{ end }}
+}
+{{- end }}
+{{- if .Results }}
+
+// {{ .Name }}Returns sets the results of every call to {{ .Name }}.
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}Returns({{ $results }}) {
+	{{ $fake }}.{{ $field }}Mutex.Lock()
+	defer {{ $fake }}.{{ $field }}Mutex.Unlock()
+	{{ $fake }}.{{ .Name }}Stub = nil
+	{{ $fake }}.{{ $field }}Returns = {{ template "counterfeiterResults" . }}{ {{- $resultsList -}} }
+}
+
+// {{ .Name }}ReturnsOnCall sets the results of the i-th call to {{ .Name }}.
+func ({{ $fake }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}ReturnsOnCall({{ $index }} int, {{ $results }}) {
+	{{ $fake }}.{{ $field }}Mutex.Lock()
+	defer {{ $fake }}.{{ $field }}Mutex.Unlock()
+	{{ $fake }}.{{ .Name }}Stub = nil
+	if {{ $fake }}.{{ $field }}ReturnsOnCall == nil {
+		{{ $fake }}.{{ $field }}ReturnsOnCall = make(map[int]{{ template "counterfeiterResults" . }})
+	}
+	{{ $fake }}.{{ $field }}ReturnsOnCall[{{ $index }}] = {{ template "counterfeiterResults" . }}{ {{- $resultsList -}} }
+}
+{{- end }}
+{{- end }}
+
+// Invocations returns the arguments of every call made to the fake, by method.
+func (fake *{{ typeName }}{{ .TypeParams.Names }}) Invocations() map[string][][]any {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]any{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *{{ typeName }}{{ .TypeParams.Names }}) recordInvocation(key string, args []any) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]any{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]any{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end -}}
+`
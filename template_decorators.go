@@ -0,0 +1,213 @@
+package main
+
+var loggingTmpl = `package {{ .Package }}
+import (
+	{{ importSpec "context" }}
+	{{ importSpec "slog" }}
+	{{ importSpec "time" }}
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// {{ typeName }} is a decorator for the {{ .Name }} interface
+// that logs each method call, along with its arguments and results.
+type {{ typeName }}{{ .TypeParams }} struct {
+	Next   {{ .Name }}{{ .TypeParams.Names }}
+	Logger *{{ pkg "slog" }}.Logger
+	Level  {{ pkg "slog" }}.Level
+}
+
+// New{{ typeName }} returns a {{ typeName }} decorator that logs
+// calls to next at the default (info) level.
+func New{{ typeName }}{{ .TypeParams }}(next {{ .Name }}{{ .TypeParams.Names }}, logger *{{ pkg "slog" }}.Logger) *{{ typeName }}{{ .TypeParams.Names }} {
+	return &{{ typeName }}{{ .TypeParams.Names }}{Next: next, Logger: logger}
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+{{- range .Methods }}
+{{- $dec := freeName . "dec" }}
+{{- $startTime := freeName . "startTime" }}
+{{- $method := . }}
+{{- $ctx := printf "%s.Background()" (pkg "context") }}
+{{- if .TakesContext }}{{ $ctx = index .Params.Names 0 }}{{ end }}
+
+// {{ .Name }} logs the call, delegates it to the underlying {{ $.Name }},
+// and logs its results.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $dec }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+	{{ $dec }}.Logger.Log({{ $ctx }}, {{ $dec }}.Level, "calling {{ $.Name }}.{{ .Name }}"
+		{{- range $i, $name := .Params.Names }}
+		{{- if or (gt $i 0) (not $method.TakesContext) }}, "{{ $name }}", {{ $name }}{{ end }}
+		{{- end }})
+	{{ $startTime }} := {{ pkg "time" }}.Now()
+	{{- if gt (len .Results) 0 }}
+	{{ .Results.VarsString }} = {{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+	{{- if .ReturnsError }}
+	if {{ last .Results.Names }} != nil {
+		{{ $dec }}.Logger.Log({{ $ctx }}, {{ pkg "slog" }}.LevelError, "{{ $.Name }}.{{ .Name }} failed",
+			"error", {{ last .Results.Names }}, "duration", {{ pkg "time" }}.Since({{ $startTime }}))
+		return {{ .Results.VarsString }}
+	}
+	{{- end }}
+	{{ $dec }}.Logger.Log({{ $ctx }}, {{ $dec }}.Level, "{{ $.Name }}.{{ .Name }} returned"
+		{{- range .Results.Names }}
+		{{- if or (not $method.ReturnsError) (ne . (last $method.Results.Names)) }}, "{{ . }}", {{ . }}{{ end }}
+		{{- end }}, "duration", {{ pkg "time" }}.Since({{ $startTime }}))
+	{{- if gt (len .Results) 0 }}
+	return {{ .Results.VarsString }}
+	{{- end }}
+}
+{{- end -}}
+`
+
+var metricsTmpl = `package {{ .Package }}
+import (
+	{{ importSpec "time" }}
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// {{ typeName }}Recorder records the duration and outcome of
+// each call made through a {{ typeName }} decorator.
+type {{ typeName }}Recorder interface {
+	RecordCall(method string, duration {{ pkg "time" }}.Duration, err error)
+}
+
+// {{ typeName }} is a decorator for the {{ .Name }} interface
+// that times each method call and reports it to a recorder.
+type {{ typeName }}{{ .TypeParams }} struct {
+	Next     {{ .Name }}{{ .TypeParams.Names }}
+	Recorder {{ typeName }}Recorder
+}
+
+// New{{ typeName }} returns a {{ typeName }} decorator that
+// reports the calls made to next to recorder.
+func New{{ typeName }}{{ .TypeParams }}(next {{ .Name }}{{ .TypeParams.Names }}, recorder {{ typeName }}Recorder) *{{ typeName }}{{ .TypeParams.Names }} {
+	return &{{ typeName }}{{ .TypeParams.Names }}{Next: next, Recorder: recorder}
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+{{- range .Methods }}
+{{- $dec := freeName . "dec" }}
+{{- $startTime := freeName . "startTime" }}
+
+// {{ .Name }} delegates the call to the underlying {{ $.Name }},
+// and records how long it took.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $dec }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+	{{ $startTime }} := {{ pkg "time" }}.Now()
+	{{- if gt (len .Results) 0 }}
+	{{ .Results.VarsString }} = {{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+	{{- if .ReturnsError }}
+	{{ $dec }}.Recorder.RecordCall("{{ .Name }}", {{ pkg "time" }}.Since({{ $startTime }}), {{ last .Results.Names }})
+	{{- else }}
+	{{ $dec }}.Recorder.RecordCall("{{ .Name }}", {{ pkg "time" }}.Since({{ $startTime }}), nil)
+	{{- end }}
+	{{- if gt (len .Results) 0 }}
+	return {{ .Results.VarsString }}
+	{{- end }}
+}
+{{- end -}}
+`
+
+var tracingTmpl = `package {{ .Package }}
+import (
+	{{ importSpec "context" }}
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// {{ typeName }}Tracer starts a span for each call made through a
+// {{ typeName }} decorator. The returned function ends the span,
+// recording the error returned by the call (if any).
+type {{ typeName }}Tracer interface {
+	Start(ctx {{ pkg "context" }}.Context, spanName string) ({{ pkg "context" }}.Context, func(err error))
+}
+
+// {{ typeName }} is a decorator for the {{ .Name }} interface
+// that wraps each method call in a span. For methods that take a
+// context.Context as their first parameter, the span's context is
+// passed on to the underlying implementation.
+type {{ typeName }}{{ .TypeParams }} struct {
+	Next   {{ .Name }}{{ .TypeParams.Names }}
+	Tracer {{ typeName }}Tracer
+}
+
+// New{{ typeName }} returns a {{ typeName }} decorator that
+// traces the calls made to next with tracer.
+func New{{ typeName }}{{ .TypeParams }}(next {{ .Name }}{{ .TypeParams.Names }}, tracer {{ typeName }}Tracer) *{{ typeName }}{{ .TypeParams.Names }} {
+	return &{{ typeName }}{{ .TypeParams.Names }}{Next: next, Tracer: tracer}
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+{{- range .Methods }}
+{{- $dec := freeName . "dec" }}
+{{- $endSpan := freeName . "endSpan" }}
+
+// {{ .Name }} delegates the call to the underlying {{ $.Name }}
+// within a "{{ $.Name }}.{{ .Name }}" span.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $dec }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+	{{- if .TakesContext }}
+	{{ index .Params.Names 0 }}, {{ $endSpan }} := {{ $dec }}.Tracer.Start({{ index .Params.Names 0 }}, "{{ $.Name }}.{{ .Name }}")
+	{{- else }}
+	_, {{ $endSpan }} := {{ $dec }}.Tracer.Start({{ pkg "context" }}.Background(), "{{ $.Name }}.{{ .Name }}")
+	{{- end }}
+	{{- if gt (len .Results) 0 }}
+	{{ .Results.VarsString }} = {{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $dec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+	{{- if .ReturnsError }}
+	{{ $endSpan }}({{ last .Results.Names }})
+	{{- else }}
+	{{ $endSpan }}(nil)
+	{{- end }}
+	{{- if gt (len .Results) 0 }}
+	return {{ .Results.VarsString }}
+	{{- end }}
+}
+{{- end -}}
+`
@@ -0,0 +1,167 @@
+package main
+
+var fakeTmpl = `package {{ .Package }}
+import (
+	"errors"
+	"sync"
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// Err{{ typeName }}NotFound is the error returned by a {{ typeName }}
+// when no value is stored under a key, unless its NotFound field is set.
+var Err{{ typeName }}NotFound = errors.New("{{ typeName }}: not found")
+
+// {{ typeName }} is a stateful, in-memory fake implementation of the
+// {{ .Name }} interface, which stores {{ .ValueType }} values by {{ .KeyType }} key.
+// Its zero value is an empty fake, ready to use. It is safe for
+// concurrent use.
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+type {{ typeName }}{{ .TypeParams }} struct {
+	{{- if .NeedsKeyFunc }}
+	// Key returns the key to store a value under, for
+	// methods that store values without an explicit key.
+	Key func({{ .ValueType }}) {{ .KeyType }}
+	{{- end }}
+
+	// NotFound is the error returned when no value is stored under
+	// a key (default: Err{{ typeName }}NotFound).
+	NotFound error
+	{{- range .FakeMethods }}
+	{{- if not .Kind }}
+	{{- with .Doc }}
+	{{ comment . }}
+	{{- end }}
+	{{ stub .Name }} func({{ .Params }}) {{ .Results }}
+	{{- end }}
+	{{- end }}
+
+	mu     sync.RWMutex
+	values map[{{ .KeyType }}]{{ .ValueType }}
+	keys   []{{ .KeyType }}
+}
+
+// Verify that *{{ typeName }} implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ typeName }}{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ typeName }}{}
+{{ end }}
+
+func (f *{{ typeName }}{{ .TypeParams.Names }}) notFound() error {
+	if f.NotFound != nil {
+		return f.NotFound
+	}
+	return Err{{ typeName }}NotFound
+}
+
+{{- range .FakeMethods }}
+{{- $results := .Results.Names }}
+{{- $err := last $results }}
+{{- $ok := freeName .Method "ok" }}
+{{- $key := freeName .Method "key" }}
+{{- $i := freeName .Method "i" }}
+{{- $f := freeName .Method "f" }}
+
+{{- if eq .Kind "get" }}
+
+// {{ .Name }} returns the value stored under the key,
+// or the NotFound error if there is none.
+{{- else if eq .Kind "put" }}
+
+// {{ .Name }} stores the value under
+{{- if .Key }} the key
+{{- else }} the key returned by Key
+{{- end }}, replacing any
+// value already stored under it.
+{{- else if eq .Kind "delete" }}
+
+// {{ .Name }} removes the value stored under the key,
+// or returns the NotFound error if there is none.
+{{- else if eq .Kind "list" }}
+
+// {{ .Name }} returns all of the stored values,
+// in the order they were first stored.
+{{- else }}
+
+// {{ .Name }} calls {{ stub .Name }}, which must be set.
+{{- end }}
+{{- with .Doc }}
+//
+{{ comment . }}
+{{- end }}
+func ({{ $f }} *{{ typeName }}{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+	{{- if eq .Kind "get" }}
+	{{ $f }}.mu.RLock()
+	defer {{ $f }}.mu.RUnlock()
+	var {{ $ok }} bool
+	{{ index $results 0 }}, {{ $ok }} = {{ $f }}.values[{{ .Key }}]
+	if !{{ $ok }} {
+		{{ $err }} = {{ $f }}.notFound()
+	}
+	return {{ .Results.VarsString }}
+
+	{{- else if eq .Kind "put" }}
+	{{- if .Key }}
+	{{ $key }} := {{ .Key }}
+	{{- else }}
+	if {{ $f }}.Key == nil {
+		panic("{{ typeName }}.Key is nil")
+	}
+	{{ $key }} := {{ $f }}.Key({{ .Value }})
+	{{- end }}
+	{{ $f }}.mu.Lock()
+	defer {{ $f }}.mu.Unlock()
+	if {{ $f }}.values == nil {
+		{{ $f }}.values = map[{{ $.KeyType }}]{{ $.ValueType }}{}
+	}
+	if _, {{ $ok }} := {{ $f }}.values[{{ $key }}]; !{{ $ok }} {
+		{{ $f }}.keys = append({{ $f }}.keys, {{ $key }})
+	}
+	{{ $f }}.values[{{ $key }}] = {{ .Value }}
+	return {{ .Results.VarsString }}
+
+	{{- else if eq .Kind "delete" }}
+	{{ $f }}.mu.Lock()
+	defer {{ $f }}.mu.Unlock()
+	if _, {{ $ok }} := {{ $f }}.values[{{ .Key }}]; !{{ $ok }} {
+		{{ $err }} = {{ $f }}.notFound()
+		return {{ .Results.VarsString }}
+	}
+	delete({{ $f }}.values, {{ .Key }})
+	for {{ $i }} := range {{ $f }}.keys {
+		if {{ $f }}.keys[{{ $i }}] == {{ .Key }} {
+			{{ $f }}.keys = append({{ $f }}.keys[:{{ $i }}], {{ $f }}.keys[{{ $i }}+1:]...)
+			break
+		}
+	}
+	return {{ .Results.VarsString }}
+
+	{{- else if eq .Kind "list" }}
+	{{ $f }}.mu.RLock()
+	defer {{ $f }}.mu.RUnlock()
+	{{ index $results 0 }} = make({{ (index .Results 0).Type }}, 0, len({{ $f }}.keys))
+	for _, {{ $key }} := range {{ $f }}.keys {
+		{{ index $results 0 }} = append({{ index $results 0 }}, {{ $f }}.values[{{ $key }}])
+	}
+	return {{ .Results.VarsString }}
+
+	{{- else }}
+	if {{ $f }}.{{ stub .Name }} == nil {
+		panic("{{ .Name }} unimplemented")
+	}
+	{{- if .Results }}
+	return {{ $f }}.{{ stub .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $f }}.{{ stub .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+	{{- end }}
+}
+{{- end -}}
+`
@@ -0,0 +1,243 @@
+package main
+
+var recordTmpl = `package {{ .Package }}
+import (
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
+)
+
+// {{ recordName }}RecordedCall is a call made through a {{ recordName }}Recorder,
+// as stored in a golden file. Context arguments are not recorded, and
+// errors are recorded as their messages.
+type {{ recordName }}RecordedCall struct {
+	Method  string            ` + "`json:\"method\"`" + `
+	Args    json.RawMessage   ` + "`json:\"args\"`" + `
+	Results []json.RawMessage ` + "`json:\"results\"`" + `
+}
+
+// {{ recordName }}Recorder is a decorator for the {{ .Name }} interface that
+// records the arguments and results of each call made to the underlying
+// implementation, so that they can be saved to a golden file and served
+// back by a {{ recordName }}Replayer.
+type {{ recordName }}Recorder{{ .TypeParams }} struct {
+	Next {{ .Name }}{{ .TypeParams.Names }}
+
+	mu    sync.Mutex
+	calls []{{ recordName }}RecordedCall
+	err   error
+}
+
+// New{{ recordName }}Recorder returns a {{ recordName }}Recorder that records
+// the calls made to next.
+func New{{ recordName }}Recorder{{ .TypeParams }}(next {{ .Name }}{{ .TypeParams.Names }}) *{{ recordName }}Recorder{{ .TypeParams.Names }} {
+	return &{{ recordName }}Recorder{{ .TypeParams.Names }}{Next: next}
+}
+
+// Verify that *{{ recordName }}Recorder implements {{ .Name }}.
+{{- if .TypeParams }}
+func _{{ .TypeParams }}() {
+    var _ {{ .Name }}{{ .TypeParams.Names }} = &{{ recordName }}Recorder{{ .TypeParams.Names }}{}
+}
+{{ else }}
+var _ {{ .Name }} = &{{ recordName }}Recorder{}
+{{ end }}
+
+// RecordedCalls returns the calls recorded so far.
+func (rec *{{ recordName }}Recorder{{ .TypeParams.Names }}) RecordedCalls() []{{ recordName }}RecordedCall {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]{{ recordName }}RecordedCall(nil), rec.calls...)
+}
+
+// SaveGolden writes the calls recorded so far to a golden file at path.
+// It returns an error if any of the calls' arguments or results could
+// not be encoded as JSON.
+func (rec *{{ recordName }}Recorder{{ .TypeParams.Names }}) SaveGolden(path string) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.err != nil {
+		return rec.err
+	}
+	data, err := json.MarshalIndent(rec.calls, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func (rec *{{ recordName }}Recorder{{ .TypeParams.Names }}) record(method string, args []any, results []any) {
+	call := {{ recordName }}RecordedCall{Method: method}
+	data, err := json.Marshal(args)
+	if err == nil {
+		call.Args = data
+		for _, result := range results {
+			data, err = json.Marshal(result)
+			if err != nil {
+				break
+			}
+			call.Results = append(call.Results, data)
+		}
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if err != nil && rec.err == nil {
+		rec.err = fmt.Errorf("error recording call to {{ .Name }}.%s: %w", method, err)
+	}
+	rec.calls = append(rec.calls, call)
+}
+
+{{- range .Methods }}
+{{- $method := . }}
+{{- $rec := freeName . "rec" }}
+
+// {{ .Name }} delegates the call to the underlying {{ $.Name }},
+// and records its arguments and results.
+func ({{ $rec }} *{{ recordName }}Recorder{{ $.TypeParams.Names }}) {{ .Name }}({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+	{{- if gt (len .Results) 0 }}
+	{{ .Results.VarsString }} = {{ $rec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- else }}
+	{{ $rec }}.Next.{{ .Name }}({{ .Params.ArgsString }})
+	{{- end }}
+	{{ $rec }}.record("{{ .Name }}", []any{
+		{{- range $i, $name := .Params.Names }}
+		{{- if or (gt $i 0) (not $method.TakesContext) }}{{ $name }}, {{ end }}
+		{{- end }}}, []any{
+		{{- range $i, $result := .Results }}
+		{{- if .IsError }}errorMessage{{ recordName }}({{ index $method.Results.Names $i }}), {{ else }}{{ index $method.Results.Names $i }}, {{ end }}
+		{{- end }}})
+	{{- if gt (len .Results) 0 }}
+	return {{ .Results.VarsString }}
+	{{- end }}
+}
+{{- end }}
+
+// {{ recordName }}Replayer serves the calls recorded by a {{ recordName }}Recorder
+// back to a {{ typeName }}. Each call to the mock is matched to the first
+// unused recorded call with the same method name and arguments. If all of
+// the matching recorded calls have already been used, the last one is
+// used again.
+type {{ recordName }}Replayer{{ .TypeParams }} struct {
+	T *testing.T
+
+	mu    sync.Mutex
+	calls []{{ recordName }}RecordedCall
+	used  []bool
+}
+
+// Load{{ recordName }}Replayer loads the calls recorded in the golden file
+// at path, failing the test if it can't be read.
+func Load{{ recordName }}Replayer{{ .TypeParams }}(t *testing.T, path string) *{{ recordName }}Replayer{{ .TypeParams.Names }} {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading {{ .Name }} golden file: %s", err)
+	}
+	var calls []{{ recordName }}RecordedCall
+	if err := json.Unmarshal(data, &calls); err != nil {
+		t.Fatalf("error decoding {{ .Name }} golden file %s: %s", path, err)
+	}
+
+	// Undo any indentation, so that the recorded arguments
+	// can be compared to the encoded arguments of each call
+	for i, call := range calls {
+		var args bytes.Buffer
+		if err := json.Compact(&args, call.Args); err != nil {
+			t.Fatalf("error decoding {{ .Name }} golden file %s: %s", path, err)
+		}
+		calls[i].Args = args.Bytes()
+	}
+	return &{{ recordName }}Replayer{{ .TypeParams.Names }}{T: t, calls: calls, used: make([]bool, len(calls))}
+}
+
+// Mock returns a {{ typeName }} whose stubs serve the recorded calls.
+{{- $rep := freeNameIn .Methods "rep" }}
+func ({{ $rep }} *{{ recordName }}Replayer{{ .TypeParams.Names }}) Mock() *{{ typeName }}{{ .TypeParams.Names }} {
+	m := &{{ typeName }}{{ .TypeParams.Names }}{T: {{ $rep }}.T}
+	{{- range .Methods }}
+	{{- $method := . }}
+	{{- $results := freeName . "results" }}
+	m.{{ stub .Name }} = func({{ .Params.NamedString }}) {{ .Results.NamedString }}{
+		{{ if gt (len .Results) 0 }}{{ $results }} := {{ end }}{{ $rep }}.replay("{{ .Name }}", {{ len .Results }}, []any{
+			{{- range $i, $name := .Params.Names }}
+			{{- if or (gt $i 0) (not $method.TakesContext) }}{{ $name }}, {{ end }}
+			{{- end }}})
+		{{- range $i, $result := .Results }}
+		{{- if .IsError }}
+		{{ index $method.Results.Names $i }} = {{ $rep }}.decodeError("{{ $method.Name }}", {{ $results }}[{{ $i }}])
+		{{- else }}
+		{{ $rep }}.decode("{{ $method.Name }}", {{ $results }}[{{ $i }}], &{{ index $method.Results.Names $i }})
+		{{- end }}
+		{{- end }}
+		{{- if gt (len .Results) 0 }}
+		return {{ .Results.VarsString }}
+		{{- end }}
+	}
+	{{- end }}
+	return m
+}
+
+func (rep *{{ recordName }}Replayer{{ .TypeParams.Names }}) replay(method string, numResults int, args []any) []json.RawMessage {
+	data, err := json.Marshal(args)
+	if err != nil {
+		rep.fail("error encoding arguments to {{ .Name }}.%s: %s", method, err)
+	}
+
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	match := -1
+	for i, call := range rep.calls {
+		if call.Method != method || !bytes.Equal(call.Args, data) {
+			continue
+		}
+		match = i
+		if !rep.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		rep.fail("no recorded call to {{ .Name }}.%s with arguments %s", method, data)
+	}
+	rep.used[match] = true
+
+	results := rep.calls[match].Results
+	if len(results) != numResults {
+		rep.fail("recorded call to {{ .Name }}.%s has %d results, expected %d", method, len(results), numResults)
+	}
+	return results
+}
+
+func (rep *{{ recordName }}Replayer{{ .TypeParams.Names }}) decode(method string, data json.RawMessage, v any) {
+	if err := json.Unmarshal(data, v); err != nil {
+		rep.fail("error decoding recorded result of {{ .Name }}.%s: %s", method, err)
+	}
+}
+
+func (rep *{{ recordName }}Replayer{{ .TypeParams.Names }}) decodeError(method string, data json.RawMessage) error {
+	var msg *string
+	rep.decode(method, data, &msg)
+	if msg == nil {
+		return nil
+	}
+	return errors.New(*msg)
+}
+
+func (rep *{{ recordName }}Replayer{{ .TypeParams.Names }}) fail(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if rep.T != nil {
+		rep.T.Error(msg)
+	}
+	panic(msg)
+}
+
+// errorMessage{{ recordName }} returns the message of a recorded error,
+// or nil if there was no error.
+func errorMessage{{ recordName }}(err error) *string {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	return &msg
+}
+`
@@ -0,0 +1,31 @@
+// Package gomock is a stub of go.uber.org/mock/gomock that declares the
+// parts of its API used by the gomock style's mocks, so that they can be
+// type-checked without this module depending on go.uber.org/mock.
+package gomock
+
+import "reflect"
+
+// TestHelper is the part of a *testing.T that a Controller uses.
+type TestHelper interface {
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Helper()
+}
+
+// Controller records the expected calls of mocks, and checks their calls.
+type Controller struct {
+	T TestHelper
+}
+
+// Call is called by a mock's methods, and returns the results of the call.
+func (ctrl *Controller) Call(receiver any, method string, args ...any) []any {
+	return nil
+}
+
+// RecordCallWithMethodType is called by a mock's recorder to expect a call.
+func (ctrl *Controller) RecordCallWithMethodType(receiver any, method string, methodType reflect.Type, args ...any) *Call {
+	return nil
+}
+
+// Call is an expected call.
+type Call struct{}
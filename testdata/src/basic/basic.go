@@ -0,0 +1,32 @@
+// Package basic declares interfaces with a variety of method signatures.
+package basic
+
+import (
+	"context"
+	"io"
+)
+
+// Empty has no methods.
+type Empty interface{}
+
+// Signatures has methods with every shape of parameter and result list.
+type Signatures interface {
+	// NoParams takes and returns nothing.
+	NoParams()
+	UnnamedParams(string, int)
+	BlankParams(_ string, _ int)
+	NamedResults() (n int, err error)
+	UnnamedResults() (int, error)
+	Variadic(format string, args ...any)
+	UnnamedVariadic(...string) bool
+	Context(ctx context.Context, id string) error
+	Funcs(f func(int) error) func() string
+}
+
+// Embedding embeds a standard library interface and an interface
+// declared in this package.
+type Embedding interface {
+	io.Reader
+	Empty
+	Close() error
+}
@@ -0,0 +1,16 @@
+// Package collide declares an interface whose methods have the same names as
+// the members generated for it by some styles, which can't be implemented.
+package collide
+
+// Cursor's Next method has the name of the field holding the implementation
+// wrapped by decorators, and its NextCalls method the name of the helper that
+// mocks generate for Next.
+type Cursor interface {
+	Next() bool
+	NextCalls() int
+}
+
+// Tape's SaveGolden method has the name of a method of every recorder.
+type Tape interface {
+	SaveGolden(path string) error
+}
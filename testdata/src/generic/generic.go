@@ -0,0 +1,20 @@
+// Package generic declares generic interfaces.
+package generic
+
+import "context"
+
+// Number is a union constraint.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// Cache is a generic key-value store.
+type Cache[K comparable, V any] interface {
+	Get(ctx context.Context, key K) (V, error)
+	Set(ctx context.Context, key K, value V) error
+}
+
+// Summer sums numbers of a constrained type.
+type Summer[N Number] interface {
+	Sum(values ...N) N
+}
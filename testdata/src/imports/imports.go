@@ -0,0 +1,24 @@
+// Package imports declares interfaces referring to types from renamed
+// and dot-imported packages.
+package imports
+
+import (
+	. "net/http"
+	stdtime "time"
+
+	"github.com/nathanjcochran/mock/testdata/src/store"
+)
+
+// Renamed refers to types from renamed and dot-imported packages.
+type Renamed interface {
+	After(d stdtime.Duration) <-chan stdtime.Time
+	Do(req *Request) (*Response, error)
+}
+
+// External refers to types from another package in the module, and
+// has params with the same names as the imported packages.
+type External interface {
+	Users() store.Users
+	Save(store store.User) error
+	Lookup(stdtime map[string]stdtime.Duration) (chan<- struct{ store.User }, error)
+}
@@ -0,0 +1,16 @@
+// Package sealed declares an interface with unexported methods, which can
+// only be implemented within the package.
+package sealed
+
+import "context"
+
+// Sealed has an unexported method with the same name as an exported one.
+type Sealed interface {
+	// Get gets the value of a key.
+	Get(key string) (string, error)
+
+	// get gets the value of a key, unless the context is done first.
+	get(ctx context.Context, key string) (string, error)
+
+	seal()
+}
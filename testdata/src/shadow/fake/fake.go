@@ -0,0 +1,8 @@
+// Package fake has the name of the receivers of the counterfeiter style's
+// methods, like the packages of fake clients (e.g. client-go's).
+package fake
+
+// Item is a type that the methods of an interface refer to.
+type Item struct {
+	ID string
+}
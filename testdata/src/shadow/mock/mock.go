@@ -0,0 +1,7 @@
+// Package mock has the name of the receivers of the moq style's methods.
+package mock
+
+// Item is a type that the methods of an interface refer to.
+type Item struct {
+	ID string
+}
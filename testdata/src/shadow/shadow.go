@@ -0,0 +1,42 @@
+// Package shadow declares interfaces whose params and results have the names
+// of packages (and receivers) that generated implementations refer to, and
+// whose types come from packages with the names of receivers.
+package shadow
+
+import (
+	"context"
+	"math/rand/v2"
+	"reflect"
+	"time"
+
+	"github.com/nathanjcochran/mock/testdata/src/shadow/fake"
+	"github.com/nathanjcochran/mock/testdata/src/shadow/mock"
+)
+
+// Clock's params and results shadow packages.
+type Clock interface {
+	// Sleep's params shadow the context, match and time packages,
+	// and its results the rand and atomic packages.
+	Sleep(context context.Context, match int64, time int64) (rand int64, atomic error)
+
+	// Now's params shadow the reflect package, and its results
+	// the slog package and the receiver of a replayer's Mock.
+	Now(reflect bool) (rep int64, slog string)
+
+	// Wait's params and results shadow the packages of their own types.
+	Wait(time time.Duration, context []context.Context) (rand *rand.Rand, deadline time.Time, err error)
+
+	// Since's result shadows the package of its param's type.
+	Since(t time.Time) (time time.Duration)
+
+	// Kind's param shadows the package of its own type,
+	// which gomock's recorders also refer to.
+	Kind(reflect reflect.Type) reflect.Kind
+}
+
+// Store's types come from packages with the names of the receivers of
+// the counterfeiter and moq styles' methods.
+type Store interface {
+	Put(item fake.Item) error
+	Get(id string) (*mock.Item, bool)
+}
@@ -0,0 +1,25 @@
+// Package store declares CRUD-shaped interfaces, which can be faked.
+package store
+
+import "context"
+
+// User is a stored value.
+type User struct {
+	ID   string
+	Name string
+}
+
+// Users stores users by ID.
+type Users interface {
+	GetUser(ctx context.Context, id string) (User, error)
+	SaveUser(ctx context.Context, user User) error
+	DeleteUser(ctx context.Context, id string) error
+	ListUsers(ctx context.Context) ([]User, error)
+	Count() int
+}
+
+// Settings stores strings by key, without contexts.
+type Settings interface {
+	Get(key string) (string, error)
+	Set(key, value string) error
+}
@@ -0,0 +1,7 @@
+// Package testonly declares an interface in a _test.go file.
+package testonly
+
+// Clock tells the time.
+type Clock interface {
+	Now() int64
+}
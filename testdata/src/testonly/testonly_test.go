@@ -0,0 +1,7 @@
+package testonly
+
+// Timer is only declared in a _test.go file.
+type Timer interface {
+	Start(clock Clock)
+	Stop() bool
+}
@@ -0,0 +1,201 @@
+// Package usage reports the stubs of generated mocks that were set, and
+// the expectations that were registered, but never called. These usually
+// indicate a test that doesn't exercise the code it was meant to.
+package usage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Stub is the usage of a stub set on a generated mock (i.e. an XxxStub
+// field), or of an expectation registered with it (i.e. with OnXxx).
+type Stub struct {
+	Mock   string
+	Method string
+
+	// Name of the stub's field, if it isn't the method's name followed
+	// by Stub (e.g. if the mock was generated with -stubsuffix)
+	Field string
+
+	// Description of the expectation, or empty for a stub
+	Expectation string
+
+	// Name of the method that registered the expectation (e.g. onGet,
+	// for an unexported get method), or empty if it's On followed by
+	// the method's name
+	OnMethod string
+
+	// Number of calls made to the stub, or matching the expectation
+	Calls int
+}
+
+func (s Stub) String() string {
+	if s.Expectation != "" {
+		if s.OnMethod != "" {
+			// The description starts with the method's name
+			return fmt.Sprintf("%s.%s%s", s.Mock, s.OnMethod, strings.TrimPrefix(s.Expectation, s.Method))
+		}
+		return fmt.Sprintf("%s.On%s", s.Mock, s.Expectation)
+	}
+	if s.Field != "" {
+		return fmt.Sprintf("%s.%s", s.Mock, s.Field)
+	}
+	return fmt.Sprintf("%s.%sStub", s.Mock, s.Method)
+}
+
+// Mock is implemented by generated mocks.
+type Mock interface {
+	// StubUsage returns the usage of each of the mock's stubs
+	// that are set, and each of its registered expectations.
+	StubUsage() []Stub
+}
+
+// Check registers a cleanup function with t that fails the test if any
+// of the mocks' stubs or expectations were never called. Their usage is
+// also recorded for the summary (see Main).
+func Check(t testing.TB, mocks ...Mock) {
+	t.Helper()
+	t.Cleanup(func() {
+		for _, stub := range record(t.Name(), mocks) {
+			if stub.Calls == 0 {
+				t.Errorf("%s was never called", stub)
+			}
+		}
+	})
+}
+
+// Record registers a cleanup function with t that records the usage
+// of the mocks' stubs and expectations for the summary (see Main),
+// without failing the test.
+func Record(t testing.TB, mocks ...Mock) {
+	t.Cleanup(func() {
+		record(t.Name(), mocks)
+	})
+}
+
+// SummaryEnv is the environment variable naming the file Main writes the
+// summary to. Since go test runs each package's tests in the package's
+// directory, a relative path results in a summary file per package.
+const SummaryEnv = "MOCK_USAGE_SUMMARY"
+
+// Main runs the tests, and then writes the summary of the usage recorded
+// by Check and Record to the file named by the MOCK_USAGE_SUMMARY
+// environment variable (if set). It returns the exit code to pass to
+// os.Exit, and is meant to be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(usage.Main(m))
+//	}
+func Main(m *testing.M) int {
+	code := m.Run()
+	if path := os.Getenv(SummaryEnv); path != "" {
+		if err := WriteSummary(path); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing mock usage summary: %s\n", err)
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+	return code
+}
+
+// MockSummary is the usage of the stubs and expectations
+// of a type of mock, across all of the tests that used it.
+type MockSummary struct {
+	Mock  string        `json:"mock"`
+	Stubs []StubSummary `json:"stubs"`
+}
+
+// StubSummary is the usage of a stub or expectation
+// across all of the tests that set or registered it.
+type StubSummary struct {
+	Method      string `json:"method"`
+	Expectation string `json:"expectation,omitempty"`
+
+	// Number of tests that set the stub or registered the
+	// expectation, and number of calls made in all of them
+	Tests int `json:"tests"`
+	Calls int `json:"calls"`
+
+	// Names of the tests in which it was never called
+	UnusedIn []string `json:"unused_in,omitempty"`
+}
+
+var (
+	mu      sync.Mutex
+	summary = map[string]map[stubKey]*StubSummary{}
+)
+
+type stubKey struct {
+	method, expectation string
+}
+
+func record(test string, mocks []Mock) []Stub {
+	var stubs []Stub
+	for _, mock := range mocks {
+		stubs = append(stubs, mock.StubUsage()...)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, stub := range stubs {
+		if summary[stub.Mock] == nil {
+			summary[stub.Mock] = map[stubKey]*StubSummary{}
+		}
+		key := stubKey{stub.Method, stub.Expectation}
+		s := summary[stub.Mock][key]
+		if s == nil {
+			s = &StubSummary{Method: stub.Method, Expectation: stub.Expectation}
+			summary[stub.Mock][key] = s
+		}
+		s.Tests++
+		s.Calls += stub.Calls
+		if stub.Calls == 0 {
+			s.UnusedIn = append(s.UnusedIn, test)
+		}
+	}
+	return stubs
+}
+
+// Summary returns the usage recorded by Check and Record so far,
+// by mock, ordered by the name of the mock and then by method.
+func Summary() []MockSummary {
+	mu.Lock()
+	defer mu.Unlock()
+	var mocks []MockSummary
+	for mock, stubs := range summary {
+		s := MockSummary{Mock: mock}
+		for _, stub := range stubs {
+			stub := *stub
+			stub.UnusedIn = append([]string(nil), stub.UnusedIn...)
+			s.Stubs = append(s.Stubs, stub)
+		}
+		sort.Slice(s.Stubs, func(i, j int) bool {
+			if s.Stubs[i].Method != s.Stubs[j].Method {
+				return s.Stubs[i].Method < s.Stubs[j].Method
+			}
+			return s.Stubs[i].Expectation < s.Stubs[j].Expectation
+		})
+		mocks = append(mocks, s)
+	}
+	sort.Slice(mocks, func(i, j int) bool {
+		return mocks[i].Mock < mocks[j].Mock
+	})
+	return mocks
+}
+
+// WriteSummary writes the usage recorded by Check and
+// Record so far to a file at path, as JSON.
+func WriteSummary(path string) error {
+	data, err := json.MarshalIndent(Summary(), "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,152 @@
+package usage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// mock is a Mock with fixed usage.
+type mock []Stub
+
+func (m mock) StubUsage() []Stub { return m }
+
+// fakeT records the errors and cleanup functions registered with it.
+type fakeT struct {
+	testing.TB
+	name     string
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Name() string     { return t.name }
+func (t *fakeT) Helper()          {}
+func (t *fakeT) Cleanup(f func()) { t.cleanups = append(t.cleanups, f) }
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+// finish runs the cleanup functions, the way the testing package
+// does once a test finishes.
+func (t *fakeT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+// resetSummary clears the usage recorded by other tests.
+func resetSummary(t *testing.T) {
+	t.Helper()
+	mu.Lock()
+	defer mu.Unlock()
+	summary = map[string]map[stubKey]*StubSummary{}
+}
+
+func TestStubString(t *testing.T) {
+	tests := []struct {
+		stub Stub
+		want string
+	}{
+		{Stub{Mock: "StoreMock", Method: "Get"}, "StoreMock.GetStub"},
+		{Stub{Mock: "StoreMock", Method: "Get", Field: "GetFunc"}, "StoreMock.GetFunc"},
+		{Stub{Mock: "StoreMock", Method: "Get", Expectation: `Get(id: == "a")`}, `StoreMock.OnGet(id: == "a")`},
+		{Stub{Mock: "StoreMock", Method: "Get", Expectation: `Get(id: == "a")`, OnMethod: "OnGet"}, `StoreMock.OnGet(id: == "a")`},
+		{Stub{Mock: "StoreMock", Method: "get", Expectation: `get(id: == "a")`, OnMethod: "onGet"}, `StoreMock.onGet(id: == "a")`},
+	}
+	for _, tt := range tests {
+		if got := tt.stub.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		mocks  []Mock
+		errors []string
+	}{
+		{"no mocks", nil, nil},
+		{"called", []Mock{mock{{Mock: "M", Method: "Get", Calls: 2}}}, nil},
+		{
+			"never called",
+			[]Mock{mock{{Mock: "M", Method: "Get", Calls: 1}, {Mock: "M", Method: "Put"}}},
+			[]string{"M.PutStub was never called"},
+		},
+		{
+			"several mocks",
+			[]Mock{mock{{Mock: "M", Method: "Get"}}, mock{{Mock: "N", Method: "Get", Expectation: "Get(id: any value)"}}},
+			[]string{"M.GetStub was never called", "N.OnGet(id: any value) was never called"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetSummary(t)
+			ft := &fakeT{name: tt.name}
+			Check(ft, tt.mocks...)
+			if len(ft.errors) > 0 {
+				t.Fatalf("Check failed the test before it finished: %q", ft.errors)
+			}
+			ft.finish()
+			if !reflect.DeepEqual(ft.errors, tt.errors) {
+				t.Errorf("errors = %q, want %q", ft.errors, tt.errors)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	resetSummary(t)
+	for _, test := range []struct {
+		name  string
+		check bool
+		mocks []Mock
+	}{
+		{"TestA", true, []Mock{mock{{Mock: "B", Method: "Put", Calls: 1}, {Mock: "B", Method: "Get"}}}},
+		{"TestB", false, []Mock{mock{{Mock: "B", Method: "Get", Calls: 2}, {Mock: "A", Method: "Get", Expectation: "Get(id: any value)"}}}},
+		{"TestC", false, []Mock{mock{{Mock: "B", Method: "Get"}, {Mock: "A", Method: "Get", Expectation: "Get(id: nil)", Calls: 1}}}},
+	} {
+		ft := &fakeT{name: test.name}
+		if test.check {
+			Check(ft, test.mocks...)
+		} else {
+			Record(ft, test.mocks...)
+		}
+		ft.finish()
+	}
+
+	want := []MockSummary{
+		{Mock: "A", Stubs: []StubSummary{
+			{Method: "Get", Expectation: "Get(id: any value)", Tests: 1, Calls: 0, UnusedIn: []string{"TestB"}},
+			{Method: "Get", Expectation: "Get(id: nil)", Tests: 1, Calls: 1},
+		}},
+		{Mock: "B", Stubs: []StubSummary{
+			{Method: "Get", Tests: 3, Calls: 2, UnusedIn: []string{"TestA", "TestC"}},
+			{Method: "Put", Tests: 1, Calls: 1},
+		}},
+	}
+	got := Summary()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summary() = %+v, want %+v", got, want)
+	}
+
+	// The summary is written as JSON
+	path := filepath.Join(t.TempDir(), "summary.json")
+	if err := WriteSummary(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written []MockSummary
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("error decoding summary: %s\n%s", err, data)
+	}
+	if !reflect.DeepEqual(written, want) {
+		t.Errorf("written summary = %+v, want %+v", written, want)
+	}
+}
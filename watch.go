@@ -0,0 +1,242 @@
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// watchDebounce is how long to wait for further changes after a file
+// changes, so that saving several files at once only regenerates once.
+const watchDebounce = 100 * time.Millisecond
+
+// watcher regenerates the output of the go:generate directives in a set of
+// directories when the interfaces they refer to change.
+type watcher struct {
+	patterns []string
+	dirs     []string
+	jobs     int
+
+	// watchDir watches a directory created after the watch started
+	watchDir func(dir string) error
+
+	// Absolute paths of the files the directives write to,
+	// which are ignored when they change
+	outputs map[string]bool
+}
+
+// watch implements the -watch flag, which watches the directories matching
+// the given patterns (see expandPatterns), and regenerates the output of the
+// go:generate directives running mock in them whenever the interfaces they
+// refer to change. Only the directives whose output's fingerprint is out of
+// date are rerun (up to jobs at a time), and their output is only written
+// if it's changed.
+func watch(patterns []string, jobs int) {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	dirs, err := absDirs(patterns)
+	if err != nil {
+		log.Fatalf("Error finding packages: %s", err)
+	}
+
+	changes := make(chan string)
+	errs := make(chan error, 1)
+	watchDir, err := watchDirs(dirs, changes, errs)
+	if err != nil {
+		log.Fatalf("Error watching packages: %s", err)
+	}
+
+	w := &watcher{
+		patterns: patterns,
+		dirs:     dirs,
+		jobs:     jobs,
+		watchDir: watchDir,
+		outputs:  map[string]bool{},
+	}
+	w.regenerate(nil)
+	log.Printf("Watching %d directories for changes", len(dirs))
+	for {
+		changed, err := nextChanges(changes, errs)
+		if err != nil {
+			log.Fatalf("Error watching packages: %s", err)
+		}
+		w.regenerate(changed)
+	}
+}
+
+// absDirs returns the absolute paths of the directories matching the
+// patterns (see expandPatterns).
+func absDirs(patterns []string) ([]string, error) {
+	dirs, err := expandPatterns(patterns)
+	if err != nil {
+		return nil, err
+	}
+	for i, dir := range dirs {
+		if dirs[i], err = filepath.Abs(dir); err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// nextChanges waits for a file to change, and returns the files that
+// changed before no more changes were made for watchDebounce.
+func nextChanges(changes <-chan string, errs <-chan error) ([]string, error) {
+	seen := map[string]bool{}
+	var files []string
+	var timeout <-chan time.Time
+	for {
+		select {
+		case file := <-changes:
+			if !seen[file] {
+				seen[file] = true
+				files = append(files, file)
+			}
+			timeout = time.After(watchDebounce)
+		case err := <-errs:
+			return nil, err
+		case <-timeout:
+			return files, nil
+		}
+	}
+}
+
+// regenerate reruns the directives in the packages affected by changes to
+// the given files (or all of them, if nil) whose interfaces have changed.
+func (w *watcher) regenerate(changed []string) {
+	var stale map[string]bool
+	if changed != nil {
+		// Changes to generated files don't affect any interfaces
+		var changedDirs []string
+		newDirs := false
+		for _, file := range changed {
+			if info, err := os.Stat(file); err == nil && info.IsDir() {
+				newDirs = true
+			} else if !w.outputs[file] {
+				changedDirs = append(changedDirs, filepath.Dir(file))
+			}
+		}
+
+		// New packages may have been created in the new directories
+		// (or in directories created in them before they were watched)
+		if newDirs {
+			added, err := w.watchNewDirs()
+			if err != nil {
+				log.Printf("Error watching packages: %s", err)
+			}
+			changedDirs = append(changedDirs, added...)
+		}
+		if len(changedDirs) == 0 {
+			return
+		}
+		var err error
+		stale, err = w.importers(changedDirs)
+		if err != nil {
+			log.Printf("Error loading packages: %s", err)
+			return
+		}
+	}
+
+	directives, err := findDirectives(w.dirs)
+	if err != nil {
+		log.Printf("Error finding go:generate directives: %s", err)
+		return
+	}
+
+	var run []directive
+	for _, d := range directives {
+		if stale == nil || stale[d.opts.dir] {
+			run = append(run, d)
+		}
+	}
+	for _, result := range runDirectives(run, w.jobs) {
+		if result.outFile != "" {
+			w.outputs[result.outFile] = true
+		}
+		switch {
+		case result.err != nil:
+			log.Printf("%s: %s", result.pos, result.err)
+		case result.written:
+			log.Printf("Regenerated %s", relative(result.outFile))
+		}
+	}
+}
+
+// watchNewDirs watches the directories matching the patterns that aren't
+// watched yet, and returns them.
+func (w *watcher) watchNewDirs() ([]string, error) {
+	dirs, err := absDirs(w.patterns)
+	if err != nil {
+		return nil, err
+	}
+	var added []string
+	for _, dir := range dirs {
+		if slices.Contains(w.dirs, dir) {
+			continue
+		}
+		if err := w.watchDir(dir); err != nil {
+			return added, err
+		}
+		w.dirs = append(w.dirs, dir)
+		added = append(added, dir)
+	}
+	if len(added) > 0 {
+		log.Printf("Watching %d new directories", len(added))
+	}
+	return added, nil
+}
+
+// importers returns the directories of the watched packages that are in the
+// given directories, or that import them (directly or indirectly).
+func (w *watcher) importers(dirs []string) (map[string]bool, error) {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedImports,
+		Tests: true,
+	}, w.patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the packages in the directories, and the
+	// packages importing each package, by import path
+	var queue []string
+	pkgDirs := map[string]string{}
+	importedBy := map[string][]string{}
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) == 0 {
+			continue
+		}
+		dir := filepath.Dir(pkg.GoFiles[0])
+		pkgDirs[pkg.PkgPath] = dir
+		for _, changed := range dirs {
+			if dir == changed {
+				queue = append(queue, pkg.PkgPath)
+			}
+		}
+		for imported := range pkg.Imports {
+			importedBy[imported] = append(importedBy[imported], pkg.PkgPath)
+		}
+	}
+
+	stale := map[string]bool{}
+	for _, dir := range dirs {
+		stale[dir] = true
+	}
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		pkgPath := queue[0]
+		queue = queue[1:]
+		if visited[pkgPath] {
+			continue
+		}
+		visited[pkgPath] = true
+		stale[pkgDirs[pkgPath]] = true
+		queue = append(queue, importedBy[pkgPath]...)
+	}
+	return stale, nil
+}
@@ -0,0 +1,79 @@
+//go:build linux
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// watchDirs watches the directories with inotify, sending the path of each
+// Go source file written, created, removed or renamed in them, and of each
+// directory created or moved into them, to changes. It returns a function
+// that watches another directory. If reading the events fails, the error is
+// sent to errs, and no more changes are sent.
+func watchDirs(dirs []string, changes chan<- string, errs chan<- error) (func(dir string) error, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+	var (
+		mu      sync.Mutex
+		watched = map[int32]string{}
+	)
+	watchDir := func(dir string) error {
+		wd, err := syscall.InotifyAddWatch(fd, dir, syscall.IN_CLOSE_WRITE|syscall.IN_CREATE|
+			syscall.IN_DELETE|syscall.IN_MOVED_FROM|syscall.IN_MOVED_TO)
+		if err != nil {
+			return fmt.Errorf("error watching %s: %w", dir, os.NewSyscallError("inotify_add_watch", err))
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		watched[int32(wd)] = dir
+		return nil
+	}
+	for _, dir := range dirs {
+		if err := watchDir(dir); err != nil {
+			syscall.Close(fd)
+			return nil, err
+		}
+	}
+
+	go func() {
+		buf := make([]byte, 64*1024)
+		for {
+			n, err := syscall.Read(fd, buf)
+			if err == syscall.EINTR {
+				continue
+			}
+			if err != nil {
+				errs <- os.NewSyscallError("read", err)
+				return
+			}
+
+			// Each event is followed by the NUL-padded name
+			// of the file in the watched directory (if any)
+			for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+				event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+				offset += syscall.SizeofInotifyEvent
+				name := string(bytes.TrimRight(buf[offset:offset+int(event.Len)], "\x00"))
+				offset += int(event.Len)
+
+				mu.Lock()
+				dir, ok := watched[event.Wd]
+				mu.Unlock()
+				newDir := event.Mask&syscall.IN_ISDIR != 0 && event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0
+				if ok && (strings.HasSuffix(name, ".go") || newDir) {
+					changes <- filepath.Join(dir, name)
+				}
+			}
+		}
+	}()
+	return watchDir, nil
+}
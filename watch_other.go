@@ -0,0 +1,93 @@
+//go:build !linux
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// watchPollInterval is how often the watched directories are
+// polled for changes on platforms without inotify.
+const watchPollInterval = 500 * time.Millisecond
+
+// watchDirs polls the directories for changes to the modification times
+// and sizes of the Go source files in them, sending the path of each file
+// written, created or removed, and of each directory created in them, to
+// changes. It returns a function that watches another directory. Errors
+// listing the directories are sent to errs, after which no more changes
+// are sent.
+func watchDirs(dirs []string, changes chan<- string, errs chan<- error) (func(dir string) error, error) {
+	type state struct {
+		modTime time.Time
+		size    int64
+		dir     bool
+	}
+	var mu sync.Mutex
+	dirs = slices.Clone(dirs)
+	poll := func() (map[string]state, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		states := map[string]state{}
+		for _, dir := range dirs {
+			entries, err := os.ReadDir(dir)
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				file := filepath.Join(dir, entry.Name())
+				if entry.IsDir() {
+					states[file] = state{dir: true}
+					continue
+				}
+				if !strings.HasSuffix(entry.Name(), ".go") {
+					continue
+				}
+				info, err := entry.Info()
+				if err != nil {
+					continue
+				}
+				states[file] = state{modTime: info.ModTime(), size: info.Size()}
+			}
+		}
+		return states, nil
+	}
+
+	prev, err := poll()
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for range time.Tick(watchPollInterval) {
+			cur, err := poll()
+			if err != nil {
+				errs <- err
+				return
+			}
+			for file, s := range cur {
+				if prevState, ok := prev[file]; !ok || (!s.dir && prevState != s) {
+					changes <- file
+				}
+			}
+			for file, s := range prev {
+				if _, ok := cur[file]; !ok && !s.dir {
+					changes <- file
+				}
+			}
+			prev = cur
+		}
+	}()
+	watchDir := func(dir string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		dirs = append(dirs, dir)
+		return nil
+	}
+	return watchDir, nil
+}